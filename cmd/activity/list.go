@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
 	coretableprinter "github.com/needmore/bc4/internal/tableprinter"
@@ -90,7 +91,11 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 
 			// Parse since flag
 			if sinceStr != "" {
-				since, err := parseSince(sinceStr)
+				loc, err := f.Location()
+				if err != nil {
+					return err
+				}
+				since, err := cmdutil.ParseSince(sinceStr, time.Now().In(loc))
 				if err != nil {
 					return fmt.Errorf("invalid --since value: %w", err)
 				}
@@ -144,7 +149,7 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
-	cmd.Flags().StringVar(&sinceStr, "since", "", "Show activity since time (e.g., '24h', '7d', '2024-01-01')")
+	cmd.Flags().StringVar(&sinceStr, "since", "", "Show activity since time (e.g., '24h', '7d', 'yesterday', 'this week', '2024-01-01')")
 	cmd.Flags().StringVarP(&recordingType, "type", "t", "", "Filter by type: todo, message, document, comment, upload")
 	cmd.Flags().StringVar(&personStr, "person", "", "Filter by person (ID, name, or email)")
 	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
@@ -153,71 +158,6 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	return cmd
 }
 
-// parseSince parses various time formats into a time.Time
-func parseSince(s string) (time.Time, error) {
-	now := time.Now()
-
-	// Trim spaces but preserve case for RFC3339 parsing
-	s = strings.TrimSpace(s)
-	sLower := strings.ToLower(s)
-
-	// Handle human-friendly durations
-	if strings.HasSuffix(sLower, "h") {
-		hours, err := parseDurationValue(strings.TrimSuffix(sLower, "h"))
-		if err == nil {
-			return now.Add(-time.Duration(hours) * time.Hour), nil
-		}
-	}
-	if strings.HasSuffix(sLower, "d") {
-		days, err := parseDurationValue(strings.TrimSuffix(sLower, "d"))
-		if err == nil {
-			return now.AddDate(0, 0, -days), nil
-		}
-	}
-	if strings.HasSuffix(sLower, "w") {
-		weeks, err := parseDurationValue(strings.TrimSuffix(sLower, "w"))
-		if err == nil {
-			return now.AddDate(0, 0, -weeks*7), nil
-		}
-	}
-
-	// Try parsing as RFC3339 (preserve original case)
-	if t, err := time.Parse(time.RFC3339, s); err == nil {
-		return t, nil
-	}
-
-	// Try parsing as date only (preserve original case)
-	if t, err := time.Parse("2006-01-02", s); err == nil {
-		return t, nil
-	}
-
-	// Try parsing relative phrases (use lowercase)
-	switch sLower {
-	case "today":
-		y, m, d := now.Date()
-		return time.Date(y, m, d, 0, 0, 0, 0, now.Location()), nil
-	case "yesterday":
-		y, m, d := now.AddDate(0, 0, -1).Date()
-		return time.Date(y, m, d, 0, 0, 0, 0, now.Location()), nil
-	case "this week":
-		// Go to start of this week (Sunday)
-		daysToSunday := int(now.Weekday())
-		return now.AddDate(0, 0, -daysToSunday), nil
-	case "last week":
-		daysToSunday := int(now.Weekday())
-		return now.AddDate(0, 0, -daysToSunday-7), nil
-	}
-
-	return time.Time{}, fmt.Errorf("unable to parse time: %s", s)
-}
-
-// parseDurationValue parses an integer from a string
-func parseDurationValue(s string) (int, error) {
-	var v int
-	_, err := fmt.Sscanf(s, "%d", &v)
-	return v, err
-}
-
 // parseTypes parses the type filter into a slice of recording types
 func parseTypes(s string) []string {
 	types := strings.Split(s, ",")
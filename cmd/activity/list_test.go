@@ -2,92 +2,8 @@ package activity
 
 import (
 	"testing"
-	"time"
 )
 
-func TestParseSince(t *testing.T) {
-	now := time.Now()
-
-	tests := []struct {
-		name    string
-		input   string
-		wantErr bool
-	}{
-		{
-			name:    "hours",
-			input:   "24h",
-			wantErr: false,
-		},
-		{
-			name:    "days",
-			input:   "7d",
-			wantErr: false,
-		},
-		{
-			name:    "weeks",
-			input:   "2w",
-			wantErr: false,
-		},
-		{
-			name:    "RFC3339",
-			input:   "2024-01-01T00:00:00Z",
-			wantErr: false,
-		},
-		{
-			name:    "date only",
-			input:   "2024-01-01",
-			wantErr: false,
-		},
-		{
-			name:    "today",
-			input:   "today",
-			wantErr: false,
-		},
-		{
-			name:    "yesterday",
-			input:   "yesterday",
-			wantErr: false,
-		},
-		{
-			name:    "this week",
-			input:   "this week",
-			wantErr: false,
-		},
-		{
-			name:    "last week",
-			input:   "last week",
-			wantErr: false,
-		},
-		{
-			name:    "invalid",
-			input:   "invalid",
-			wantErr: true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result, err := parseSince(tt.input)
-			if tt.wantErr {
-				if err == nil {
-					t.Errorf("parseSince() expected error for input %q, got nil", tt.input)
-				}
-				return
-			}
-
-			if err != nil {
-				t.Errorf("parseSince() unexpected error for input %q: %v", tt.input, err)
-				return
-			}
-
-			// Verify the result is before now
-			if !result.Before(now) {
-				t.Errorf("parseSince() result should be before now for input %q", tt.input)
-			}
-		})
-	}
-}
-
 func TestParseTypes(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -205,52 +121,3 @@ func TestFormatRecordingType(t *testing.T) {
 		})
 	}
 }
-
-func TestParseDurationValue(t *testing.T) {
-	tests := []struct {
-		name     string
-		input    string
-		expected int
-		wantErr  bool
-	}{
-		{
-			name:     "single digit",
-			input:    "7",
-			expected: 7,
-			wantErr:  false,
-		},
-		{
-			name:     "multiple digits",
-			input:    "24",
-			expected: 24,
-			wantErr:  false,
-		},
-		{
-			name:     "invalid",
-			input:    "abc",
-			expected: 0,
-			wantErr:  true,
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result, err := parseDurationValue(tt.input)
-			if tt.wantErr {
-				if err == nil {
-					t.Errorf("parseDurationValue() expected error for input %q, got nil", tt.input)
-				}
-				return
-			}
-
-			if err != nil {
-				t.Errorf("parseDurationValue() unexpected error for input %q: %v", tt.input, err)
-				return
-			}
-
-			if result != tt.expected {
-				t.Errorf("parseDurationValue(%q) = %d, expected %d", tt.input, result, tt.expected)
-			}
-		})
-	}
-}
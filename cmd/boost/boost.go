@@ -0,0 +1,107 @@
+package boost
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type boostOptions struct {
+	accountID  string
+	projectID  string
+	jsonOutput bool
+}
+
+// NewBoostCmd creates the boost command
+func NewBoostCmd(f *factory.Factory) *cobra.Command {
+	opts := &boostOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "boost <recording-id|url> <content>",
+		Short: "Boost a recording with a short reaction",
+		Long: `Boost a Basecamp recording (todo, message, comment, card, and so on) with a
+short reaction, usually an emoji.
+
+Use 'bc4 boost list' to see who boosted a recording and 'bc4 boost delete'
+to take a boost back.`,
+		Example: `  # Boost a message
+  bc4 boost https://3.basecamp.com/1234567/buckets/89012345/messages/222 "🎉"
+
+  # Boost a comment in the current project by ID
+  bc4 boost 333 "👍"
+
+  # See who boosted a todo
+  bc4 boost list 111`,
+		Aliases: []string{"boosts"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runBoost(f, opts, args[0], args[1])
+		},
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+
+	// Add subcommands
+	cmd.AddCommand(newListCmd(f))
+	cmd.AddCommand(newDeleteCmd(f))
+
+	return cmd
+}
+
+func runBoost(f *factory.Factory, opts *boostOptions, arg, content string) error {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return fmt.Errorf("boost content cannot be empty")
+	}
+
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, recordingID, err := cmdutil.ResolveRecordingArg(f, arg)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	boost, err := client.Boosts().CreateBoost(f.Context(), projectID, recordingID, api.BoostCreateRequest{
+		Content: content,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(boost)
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Boosted #%d with %s\n", recordingID, boost.Content)
+	} else {
+		fmt.Println(boost.ID)
+	}
+
+	return nil
+}
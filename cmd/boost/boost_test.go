@@ -0,0 +1,28 @@
+package boost
+
+import (
+	"testing"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBoostCmd(t *testing.T) {
+	cmd := NewBoostCmd(factory.New())
+
+	assert.Equal(t, "boost", cmd.Name())
+	for _, name := range []string{"list", "delete"} {
+		sub, _, err := cmd.Find([]string{name, "123"})
+		require.NoError(t, err)
+		assert.Equal(t, name, sub.Name())
+	}
+
+	assert.Error(t, cmd.Args(cmd, []string{"123"}))
+	assert.NoError(t, cmd.Args(cmd, []string{"123", "🎉"}))
+}
+
+func TestRunBoostRejectsEmptyContent(t *testing.T) {
+	err := runBoost(factory.New(), &boostOptions{}, "123", "  ")
+	assert.EqualError(t, err, "boost content cannot be empty")
+}
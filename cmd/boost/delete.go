@@ -0,0 +1,67 @@
+package boost
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+type deleteOptions struct {
+	accountID string
+	projectID string
+}
+
+func newDeleteCmd(f *factory.Factory) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <boost-id>",
+		Short: "Delete a boost",
+		Long:  `Delete one of your boosts. Find boost IDs with 'bc4 boost list'.`,
+		Example: `  # Take back a boost
+  bc4 boost delete 444`,
+		Aliases: []string{"rm", "remove"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+func runDelete(f *factory.Factory, opts *deleteOptions, arg string) error {
+	boostID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid boost ID: %s", arg)
+	}
+
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Boosts().DeleteBoost(f.Context(), projectID, boostID); err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Deleted boost #%d\n", boostID)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package boost
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type listOptions struct {
+	accountID  string
+	projectID  string
+	jsonOutput bool
+}
+
+func newListCmd(f *factory.Factory) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <recording-id|url>",
+		Short: "List boosts on a recording",
+		Long:  `List all boosts on a recording, with who gave them and when.`,
+		Example: `  # List boosts on a message
+  bc4 boost list https://3.basecamp.com/1234567/buckets/89012345/messages/222
+
+  # Output as JSON
+  bc4 boost list 222 --json`,
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runList(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+func runList(f *factory.Factory, opts *listOptions, arg string) error {
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, recordingID, err := cmdutil.ResolveRecordingArg(f, arg)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	boosts, err := client.Boosts().ListBoosts(f.Context(), projectID, recordingID)
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(boosts)
+	}
+
+	if len(boosts) == 0 {
+		fmt.Println("No boosts found.")
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+	table.AddHeader("ID", "BOOST", "BY", "WHEN")
+
+	cs := table.GetColorScheme()
+	now := time.Now()
+	for _, boost := range boosts {
+		table.AddIDField(strconv.FormatInt(boost.ID, 10), "active")
+		table.AddField(boost.Content)
+		table.AddField(boost.Booster.Name, cs.Bold)
+		table.AddTimeField(now, boost.CreatedAt)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
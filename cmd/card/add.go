@@ -6,9 +6,12 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/attachments"
+	"github.com/needmore/bc4/internal/cardtemplate"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
@@ -16,16 +19,25 @@ import (
 	"github.com/spf13/cobra"
 )
 
+type addOptions struct {
+	accountID   string
+	projectID   string
+	tableID     string
+	columnName  string
+	columnID    int64 // set by 'card create', whose --column takes an ID
+	assignees   []string
+	steps       []string
+	dueOn       string
+	description string
+	attach      []string
+	notify      bool
+	subscribe   []string
+	template    string
+	onHold      bool
+}
+
 func newAddCmd(f *factory.Factory) *cobra.Command {
-	var accountID string
-	var projectID string
-	var tableID string
-	var columnName string
-	var assignees []string
-	var steps []string
-	var dueOn string
-	var description string
-	var attach []string
+	opts := &addOptions{}
 
 	cmd := &cobra.Command{
 		Use:   "add \"Title\"",
@@ -35,7 +47,12 @@ func newAddCmd(f *factory.Factory) *cobra.Command {
 Use flags to specify table, column, assignees, and initial steps.
 
 Use --attach to add images or files to the card content. Multiple files
-can be attached by using the flag multiple times.`,
+can be attached by using the flag multiple times.
+
+Use --template to start from a card template with a title pattern, content,
+column, assignees, due date and steps; see 'bc4 card template list'. Flags
+given alongside a template override its column, content and due date, and
+add to its assignees and steps.`,
 		Example: `  # Create a simple card
   bc4 card add "New feature"
 
@@ -46,216 +63,341 @@ can be attached by using the flag multiple times.`,
   bc4 card add "Design review" --attach ./mockup.png
 
   # Create a card with multiple attachments
-  bc4 card add "Asset update" --attach ./logo.png --attach ./banner.jpg`,
+  bc4 card add "Asset update" --attach ./logo.png --attach ./banner.jpg
+
+  # Create a card assigned to Jane and notify her about it
+  bc4 card add "Release checklist" --assign @jane --notify
+
+  # Create a card and subscribe teammates to it
+  bc4 card add "Release checklist" --subscribe @jane,bob@example.com
+
+  # Create a card due Friday with steps, assigned to Jane, and put it on hold
+  bc4 card add "Vendor contract" --due fri --assign @jane --step "Review" --step "Sign" --on-hold
+
+  # Create a card from the "bug" template
+  bc4 card add "Login broken" --template bug`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			title := args[0]
+			return runAdd(f, opts, args[0])
+		},
+	}
 
-			// Apply overrides if specified
-			if accountID != "" {
-				f = f.WithAccount(accountID)
-			}
-			if projectID != "" {
-				f = f.WithProject(projectID)
-			}
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVar(&opts.tableID, "table", "", "Specify card table ID or URL")
+	cmd.Flags().StringVar(&opts.columnName, "column", "", "Target column name or ID")
+	cmd.Flags().StringSliceVar(&opts.assignees, "assign", []string{}, "Add assignees by email or @mention (comma-separated)")
+	cmd.Flags().StringSliceVar(&opts.steps, "step", []string{}, "Add steps (can be used multiple times)")
+	cmd.Flags().StringVar(&opts.dueOn, "due", "", "Set due date (YYYY-MM-DD, or e.g. today, fri, next monday, +3d)")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Card description")
+	cmd.Flags().StringSliceVar(&opts.attach, "attach", nil, "Attach file(s) to the card (can be used multiple times)")
+	cmd.Flags().BoolVar(&opts.notify, "notify", false, "Notify the assignees about the new card")
+	cmd.Flags().StringSliceVar(&opts.subscribe, "subscribe", nil, "Subscribe people to the card (by email or @mention)")
+	cmd.Flags().StringVarP(&opts.template, "template", "t", "", "Create the card from a card template (name or path)")
+	cmd.Flags().BoolVar(&opts.onHold, "on-hold", false, "Put the card on hold in its column")
 
-			// Get API client from factory
-			client, err := f.ApiClient()
-			if err != nil {
-				return err
-			}
-			cardOps := client.Cards()
-			stepOps := client.Steps()
+	return cmd
+}
 
-			// Get resolved project ID
-			resolvedProjectID, err := f.ProjectID()
-			if err != nil {
-				return err
-			}
+// runAdd creates a card, optionally starting from a card template
+func runAdd(f *factory.Factory, opts *addOptions, title string) error {
+	// Apply overrides if specified
+	if opts.accountID != "" {
+		f = f.WithAccount(opts.accountID)
+	}
+	if opts.projectID != "" {
+		f = f.WithProject(opts.projectID)
+	}
 
-			// Get config for default lookups
-			cfg, err := f.Config()
-			if err != nil {
-				return err
-			}
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+
+	// Template settings fill in whatever the flags leave out
+	columnName := opts.columnName
+	description := opts.description
+	dueOn := opts.dueOn
+	assignees := opts.assignees
+	var steps []cardtemplate.Step
+	if opts.template != "" {
+		tmpl, err := loadCardTemplate(opts.template)
+		if err != nil {
+			return err
+		}
+		title, err = tmpl.RenderTitle(title, time.Now().In(loc))
+		if err != nil {
+			return err
+		}
+		if columnName == "" {
+			columnName = tmpl.Column
+		}
+		if description == "" {
+			description = tmpl.Body
+		}
+		if dueOn == "" {
+			dueOn = tmpl.Due
+		}
+		assignees = append(append([]string{}, tmpl.Assignees...), assignees...)
+		steps = tmpl.Steps
+	}
+	for _, stepTitle := range opts.steps {
+		steps = append(steps, cardtemplate.Step{Title: stepTitle})
+	}
+
+	// Get API client from factory
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	cardOps := client.Cards()
+
+	// Get resolved project ID
+	resolvedProjectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
 
-			// Get resolved account ID for defaults
-			resolvedAccountID, err := f.AccountID()
+	// Get config for default lookups
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+
+	// Get resolved account ID for defaults
+	resolvedAccountID, err := f.AccountID()
+	if err != nil {
+		return err
+	}
+
+	// Get card table ID
+	var cardTableID int64
+	if opts.tableID != "" {
+		// Check if it's a URL
+		if parser.IsBasecampURL(opts.tableID) {
+			parsed, err := parser.ParseBasecampURL(opts.tableID)
 			if err != nil {
-				return err
+				return fmt.Errorf("invalid Basecamp URL: %w", err)
 			}
-
-			// Get card table ID
-			var cardTableID int64
-			if tableID != "" {
-				// Check if it's a URL
-				if parser.IsBasecampURL(tableID) {
-					parsed, err := parser.ParseBasecampURL(tableID)
-					if err != nil {
-						return fmt.Errorf("invalid Basecamp URL: %w", err)
-					}
-					if parsed.ResourceType != parser.ResourceTypeCardTable {
-						return fmt.Errorf("URL is not a card table URL: %s", tableID)
-					}
-					cardTableID = parsed.ResourceID
-				} else {
-					// Parse specified table ID
-					if id, err := strconv.ParseInt(tableID, 10, 64); err == nil {
-						cardTableID = id
-					} else {
-						// Search by name not implemented yet
-						return fmt.Errorf("searching card tables by name not yet implemented")
-					}
-				}
+			if parsed.ResourceType != parser.ResourceTypeCardTable {
+				return fmt.Errorf("URL is not a card table URL: %s", opts.tableID)
+			}
+			cardTableID = parsed.ResourceID
+		} else {
+			// Parse specified table ID
+			if id, err := strconv.ParseInt(opts.tableID, 10, 64); err == nil {
+				cardTableID = id
 			} else {
-				// Use default card table
-				if acc, ok := cfg.Accounts[resolvedAccountID]; ok {
-					if proj, ok := acc.ProjectDefaults[resolvedProjectID]; ok && proj.DefaultCardTable != "" {
-						if id, err := strconv.ParseInt(proj.DefaultCardTable, 10, 64); err == nil {
-							cardTableID = id
-						}
-					}
-				}
-				if cardTableID == 0 {
-					// No default set, get the project's card table
-					cardTable, err := cardOps.GetProjectCardTable(f.Context(), resolvedProjectID)
-					if err != nil {
-						return fmt.Errorf("failed to fetch card table: %w", err)
-					}
-					cardTableID = cardTable.ID
+				// Search by name not implemented yet
+				return fmt.Errorf("searching card tables by name not yet implemented")
+			}
+		}
+	} else {
+		// Use default card table
+		if acc, ok := cfg.Accounts[resolvedAccountID]; ok {
+			if proj, ok := acc.ProjectDefaults[resolvedProjectID]; ok && proj.DefaultCardTable != "" {
+				if id, err := strconv.ParseInt(proj.DefaultCardTable, 10, 64); err == nil {
+					cardTableID = id
 				}
 			}
-
-			// Get the card table to find columns
-			cardTable, err := cardOps.GetCardTable(f.Context(), resolvedProjectID, cardTableID)
+		}
+		if cardTableID == 0 {
+			// No default set, get the project's card table
+			cardTable, err := cardOps.GetProjectCardTable(f.Context(), resolvedProjectID)
 			if err != nil {
 				return fmt.Errorf("failed to fetch card table: %w", err)
 			}
+			cardTableID = cardTable.ID
+		}
+	}
 
-			// Find the target column
-			var targetColumn *api.Column
-			if columnName != "" {
-				// Find column by name
-				for i := range cardTable.Lists {
-					if strings.Contains(strings.ToLower(cardTable.Lists[i].Title), strings.ToLower(columnName)) {
-						targetColumn = &cardTable.Lists[i]
-						break
-					}
-				}
-				if targetColumn == nil {
-					return fmt.Errorf("column '%s' not found", columnName)
-				}
-			} else {
-				// Use first non-triage column (usually the second column)
-				for i := range cardTable.Lists {
-					if cardTable.Lists[i].Type != "Kanban::Triage" {
-						targetColumn = &cardTable.Lists[i]
-						break
-					}
-				}
-				if targetColumn == nil && len(cardTable.Lists) > 0 {
-					// Fallback to first column
-					targetColumn = &cardTable.Lists[0]
-				}
-			}
+	// Get the card table to find columns
+	cardTable, err := cardOps.GetCardTable(f.Context(), resolvedProjectID, cardTableID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch card table: %w", err)
+	}
 
-			if targetColumn == nil {
-				return fmt.Errorf("no suitable column found in card table")
+	// Find the target column
+	var targetColumn *api.Column
+	if opts.columnID != 0 {
+		for i := range cardTable.Lists {
+			if cardTable.Lists[i].ID == opts.columnID {
+				targetColumn = &cardTable.Lists[i]
+				break
 			}
-
-			// Convert description to rich text if provided
-			var richContent string
-			if description != "" {
-				converter := markdown.NewConverter()
-				rc, err := converter.MarkdownToRichText(description)
-				if err != nil {
-					return fmt.Errorf("failed to convert description: %w", err)
-				}
-				richContent = rc
+		}
+		if targetColumn == nil {
+			return fmt.Errorf("column ID %d not found in card table '%s'", opts.columnID, cardTable.Title)
+		}
+	} else if columnName != "" {
+		// Find column by name
+		for i := range cardTable.Lists {
+			if strings.Contains(strings.ToLower(cardTable.Lists[i].Title), strings.ToLower(columnName)) {
+				targetColumn = &cardTable.Lists[i]
+				break
 			}
-
-			// Handle attachments
-			if len(attach) > 0 {
-				for _, attachPath := range attach {
-					fileData, err := os.ReadFile(attachPath)
-					if err != nil {
-						return fmt.Errorf("failed to read attachment %s: %w", attachPath, err)
-					}
-					filename := filepath.Base(attachPath)
-					upload, err := client.UploadAttachment(filename, fileData, "")
-					if err != nil {
-						return fmt.Errorf("failed to upload attachment %s: %w", filename, err)
-					}
-					tag := attachments.BuildTag(upload.AttachableSGID)
-					richContent += tag
-				}
+		}
+		if targetColumn == nil {
+			return fmt.Errorf("column '%s' not found", columnName)
+		}
+	} else {
+		// Use first non-triage column (usually the second column)
+		for i := range cardTable.Lists {
+			if cardTable.Lists[i].Type != columnTypeTriage {
+				targetColumn = &cardTable.Lists[i]
+				break
 			}
+		}
+		if targetColumn == nil && len(cardTable.Lists) > 0 {
+			// Fallback to first column
+			targetColumn = &cardTable.Lists[0]
+		}
+	}
 
-			// Create the card
-			req := api.CardCreateRequest{
-				Title:   title,
-				Content: richContent,
+	if targetColumn == nil {
+		return fmt.Errorf("no suitable column found in card table")
+	}
+
+	// Convert description to rich text if provided
+	var richContent string
+	if description != "" {
+		converter := markdown.NewConverter()
+		rc, err := converter.MarkdownToRichText(description)
+		if err != nil {
+			return fmt.Errorf("failed to convert description: %w", err)
+		}
+		richContent = rc
+	}
+
+	// Handle attachments
+	if len(opts.attach) > 0 {
+		for _, attachPath := range opts.attach {
+			fileData, err := os.ReadFile(attachPath)
+			if err != nil {
+				return fmt.Errorf("failed to read attachment %s: %w", attachPath, err)
 			}
-			if dueOn != "" {
-				req.DueOn = &dueOn
+			filename := filepath.Base(attachPath)
+			upload, err := client.UploadAttachment(filename, fileData, "")
+			if err != nil {
+				return fmt.Errorf("failed to upload attachment %s: %w", filename, err)
 			}
+			tag := attachments.BuildTag(upload.AttachableSGID)
+			richContent += tag
+		}
+	}
+
+	// Resolve assignees up front so they are set when the card is created
+	userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+	var assigneeIDs []int64
+	if len(assignees) > 0 {
+		assigneeIDs, err = userResolver.ResolveUsers(f.Context(), assignees)
+		if err != nil {
+			return fmt.Errorf("failed to resolve assignees: %w", err)
+		}
+	}
+
+	// Create the card
+	req := api.CardCreateRequest{
+		Title:       title,
+		Content:     richContent,
+		AssigneeIDs: assigneeIDs,
+		Notify:      opts.notify,
+	}
+	if dueOn != "" {
+		due, err := cmdutil.ResolveDate("Due date", dueOn, loc)
+		if err != nil {
+			return err
+		}
+		req.DueOn = &due
+	}
 
-			card, err := cardOps.CreateCard(f.Context(), resolvedProjectID, targetColumn.ID, req)
+	card, err := cardOps.CreateCard(f.Context(), resolvedProjectID, targetColumn.ID, req)
+	if err != nil {
+		return fmt.Errorf("failed to create card: %w", err)
+	}
+
+	fmt.Printf("Created card #%d: %s in column '%s'\n", card.ID, card.Title, targetColumn.Title)
+	if len(assigneeIDs) > 0 {
+		fmt.Printf("Assigned %d user(s) to the card\n", len(assigneeIDs))
+	}
+
+	if len(opts.subscribe) > 0 {
+		subscribeIDs, err := userResolver.ResolveUsers(f.Context(), opts.subscribe)
+		if err != nil {
+			fmt.Printf("Warning: failed to resolve people to subscribe: %v\n", err)
+		} else if len(subscribeIDs) > 0 {
+			_, err := client.Subscriptions().UpdateSubscription(f.Context(), resolvedProjectID, card.ID, api.SubscriptionUpdateRequest{
+				Subscriptions: subscribeIDs,
+			})
 			if err != nil {
-				return fmt.Errorf("failed to create card: %w", err)
+				fmt.Printf("Warning: failed to subscribe people: %v\n", err)
+			} else {
+				fmt.Printf("Subscribed %d user(s) to the card\n", len(subscribeIDs))
 			}
+		}
+	}
 
-			fmt.Printf("Created card #%d: %s in column '%s'\n", card.ID, card.Title, targetColumn.Title)
-
-			// Handle assignees - resolve user identifiers
-			if len(assignees) > 0 {
-				// Create user resolver
-				userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
-
-				// Resolve user identifiers to person IDs
-				assigneeIDs, err := userResolver.ResolveUsers(f.Context(), assignees)
-				if err != nil {
-					fmt.Printf("Warning: failed to resolve assignees: %v\n", err)
-				} else if len(assigneeIDs) > 0 {
-					updateReq := api.CardUpdateRequest{
-						AssigneeIDs: assigneeIDs,
-					}
-					_, err := cardOps.UpdateCard(f.Context(), resolvedProjectID, card.ID, updateReq)
-					if err != nil {
-						fmt.Printf("Warning: failed to assign users: %v\n", err)
-					} else {
-						fmt.Printf("Assigned %d user(s) to the card\n", len(assigneeIDs))
-					}
-				}
-			}
+	addCardSteps(f, client, userResolver, resolvedProjectID, card.ID, steps, loc)
 
-			// Add steps if provided
-			if len(steps) > 0 {
-				fmt.Printf("Adding %d steps...\n", len(steps))
-				for _, stepTitle := range steps {
-					stepReq := api.StepCreateRequest{
-						Title: stepTitle,
-					}
-					_, err := stepOps.CreateStep(f.Context(), resolvedProjectID, card.ID, stepReq)
-					if err != nil {
-						fmt.Printf("Warning: failed to add step '%s': %v\n", stepTitle, err)
-					}
-				}
-			}
+	if opts.onHold {
+		holdCard(f, client, resolvedProjectID, card.ID, targetColumn)
+	}
 
-			return nil
-		},
+	return nil
+}
+
+// addCardSteps creates steps on a new card. Problems with a step are
+// reported as warnings so the card itself is kept.
+func addCardSteps(f *factory.Factory, client *api.ModularClient, userResolver *utils.UserResolver, projectID string, cardID int64, steps []cardtemplate.Step, loc *time.Location) {
+	if len(steps) == 0 {
+		return
+	}
+
+	fmt.Printf("Adding %d steps...\n", len(steps))
+	for _, step := range steps {
+		stepReq := api.StepCreateRequest{
+			Title: step.Title,
+		}
+		if step.Due != "" {
+			due, err := cmdutil.ResolveDate("Step due date", step.Due, loc)
+			if err != nil {
+				fmt.Printf("Warning: step '%s': %v\n", step.Title, err)
+			} else {
+				stepReq.DueOn = &due
+			}
+		}
+		if len(step.Assignees) > 0 {
+			ids, err := userResolver.ResolveUsers(f.Context(), step.Assignees)
+			if err != nil {
+				fmt.Printf("Warning: failed to resolve assignees of step '%s': %v\n", step.Title, err)
+			} else {
+				stepReq.Assignees = formatIDs(ids)
+			}
+		}
+		_, err := client.Steps().CreateStep(f.Context(), projectID, cardID, stepReq)
+		if err != nil {
+			fmt.Printf("Warning: failed to add step '%s': %v\n", step.Title, err)
+		}
 	}
+}
 
-	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
-	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
-	cmd.Flags().StringVar(&tableID, "table", "", "Specify card table ID or URL")
-	cmd.Flags().StringVar(&columnName, "column", "", "Target column name")
-	cmd.Flags().StringSliceVar(&assignees, "assign", []string{}, "Add assignees by email or @mention (comma-separated)")
-	cmd.Flags().StringSliceVar(&steps, "step", []string{}, "Add steps (can be used multiple times)")
-	cmd.Flags().StringVar(&dueOn, "due", "", "Set due date (YYYY-MM-DD)")
-	cmd.Flags().StringVarP(&description, "description", "d", "", "Card description")
-	cmd.Flags().StringSliceVar(&attach, "attach", nil, "Attach file(s) to the card (can be used multiple times)")
+// holdCard moves a new card into the on-hold section of its column
+func holdCard(f *factory.Factory, client *api.ModularClient, projectID string, cardID int64, column *api.Column) {
+	onHoldID := column.OnHold.ID
+	if onHoldID == 0 {
+		// Column listings don't always carry the on-hold section
+		if fresh, err := client.Columns().GetColumn(f.Context(), projectID, column.ID); err == nil {
+			onHoldID = fresh.OnHold.ID
+		}
+	}
+	if onHoldID == 0 {
+		fmt.Printf("Warning: column '%s' has no on-hold section; enable it with 'bc4 card column hold %d'\n", column.Title, column.ID)
+		return
+	}
 
-	return cmd
+	if err := client.Cards().MoveCard(f.Context(), projectID, cardID, onHoldID); err != nil {
+		fmt.Printf("Warning: failed to put the card on hold: %v\n", err)
+		return
+	}
+	fmt.Println("Put the card on hold")
 }
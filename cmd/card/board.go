@@ -0,0 +1,105 @@
+package card
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/tui"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+func newBoardCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var refresh time.Duration
+
+	cmd := &cobra.Command{
+		Use:   "board [table]",
+		Short: "Open an interactive kanban board for a card table",
+		Long: `Open a full-screen kanban board showing the columns of a card table side by
+side, with their colors, card counts and on-hold sections.
+
+The table may be given by ID, name, or URL; without one the default card
+table is used.
+
+Keys:
+  ←/→ h/l        select column
+  ↑/↓ k/j        select card
+  H/L  shift+←/→ move the card to the previous or next column
+  o              put the card on hold, or release it
+  enter          open the card with its steps and comments
+  space          check or uncheck the selected step (in the card view)
+  a              assign people
+  x              archive the card
+  r              refresh now
+  q / esc        back or quit
+
+The board refreshes itself every 30 seconds; change this with --refresh.`,
+		Example: `  # Open the default card table
+  bc4 card board
+
+  # Open a card table by name and refresh every 10 seconds
+  bc4 card board "Product roadmap" --refresh 10s
+
+  # Disable periodic refreshing
+  bc4 card board --refresh 0`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !ui.IsTerminal(os.Stdout) || !ui.IsTerminal(os.Stdin) {
+				return fmt.Errorf("the board needs an interactive terminal; use 'bc4 card table' instead")
+			}
+
+			// Apply overrides if specified
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+
+			identifier := ""
+			if len(args) > 0 {
+				identifier = args[0]
+			}
+			f, err := withCardTableURL(f, identifier)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+
+			cardTable, err := resolveCardTable(f, client, resolvedProjectID, identifier)
+			if err != nil {
+				return err
+			}
+
+			model := tui.NewBoardModel(f.Context(), client.Client, resolvedProjectID, cardTable.ID, refresh)
+			finalModel, err := tea.NewProgram(model, tea.WithAltScreen()).Run()
+			if err != nil {
+				return err
+			}
+			if m, ok := finalModel.(tui.BoardModel); ok {
+				return m.Err()
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().DurationVar(&refresh, "refresh", 30*time.Second, "How often to reload the board (0 to disable)")
+
+	return cmd
+}
@@ -37,12 +37,19 @@ like software bugs, design requests, or other workflow-oriented tasks.`,
 	// Add subcommands
 	cmd.AddCommand(newListCmd(f))
 	cmd.AddCommand(newTableCmd(f))
+	cmd.AddCommand(newBoardCmd(f))
+	cmd.AddCommand(newMetricsCmd(f))
+	cmd.AddCommand(newLintCmd(f))
 	cmd.AddCommand(newViewCmd(f))
 	cmd.AddCommand(newSetCmd(f))
 	cmd.AddCommand(newAddCmd(f))
 	cmd.AddCommand(newCreateCmd(f))
+	cmd.AddCommand(newTemplateCmd(f))
 	cmd.AddCommand(newEditCmd(f))
 	cmd.AddCommand(newMoveCmd(f))
+	cmd.AddCommand(newCopyCmd(f))
+	cmd.AddCommand(newExportCmd(f))
+	cmd.AddCommand(newImportCmd(f))
 	cmd.AddCommand(newAssignCmd(f))
 	cmd.AddCommand(newUnassignCmd(f))
 	cmd.AddCommand(newArchiveCmd(f))
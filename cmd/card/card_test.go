@@ -20,12 +20,19 @@ func TestNewCardCmd(t *testing.T) {
 	subcommands := []string{
 		"list",
 		"table",
+		"board",
+		"metrics",
+		"lint",
 		"view",
 		"set",
 		"add",
 		"create",
+		"template",
 		"edit",
 		"move",
+		"copy",
+		"export",
+		"import",
 		"assign",
 		"unassign",
 		"archive",
@@ -22,7 +22,9 @@ func newColumnCmd(f *factory.Factory) *cobra.Command {
 	cmd.AddCommand(newColumnCreateCmd(f))
 	cmd.AddCommand(newColumnEditCmd(f))
 	cmd.AddCommand(newColumnMoveCmd(f))
+	cmd.AddCommand(newColumnSortCmd(f))
 	cmd.AddCommand(newColumnColorCmd(f))
+	cmd.AddCommand(newColumnLimitCmd(f))
 	cmd.AddCommand(newColumnHoldCmd(f))
 	cmd.AddCommand(newColumnUnholdCmd(f))
 
@@ -26,6 +26,7 @@ You can specify the column using either:
 Examples:
   bc4 card column edit 123 --title "Done"
   bc4 card column edit 123 --description "Completed tasks"
+  bc4 card column edit 123 --description ""
   bc4 card column edit 123 --title "In Review" --description "Items awaiting review"
   bc4 card column edit https://3.basecamp.com/1234567/buckets/89012345/card_tables/columns/12345 --title "Done"`,
 		Args: cobra.ExactArgs(1),
@@ -69,12 +70,16 @@ Examples:
 				return err
 			}
 
-			// Get title and description from flags
+			// Get title and description from flags; an empty --description clears it
 			title, _ := cmd.Flags().GetString("title")
-			description, _ := cmd.Flags().GetString("description")
+			var description *string
+			if cmd.Flags().Changed("description") {
+				value, _ := cmd.Flags().GetString("description")
+				description = &value
+			}
 
 			// Validate that at least one field is being updated
-			if title == "" && description == "" {
+			if title == "" && description == nil {
 				return fmt.Errorf("at least one of --title or --description must be specified")
 			}
 
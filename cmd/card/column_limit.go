@@ -0,0 +1,130 @@
+package card
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/spf13/cobra"
+)
+
+func newColumnLimitCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+
+	cmd := &cobra.Command{
+		Use:   "limit [COLUMN_ID or URL] LIMIT",
+		Short: "Set the WIP limit of a column",
+		Long: `Set the work-in-progress limit of a column. Basecamp has no WIP limits of its
+own, so the limit is stored in your bc4 configuration; 'bc4 card move' refuses
+moves that would break it and 'bc4 card lint' reports columns over it. Cards
+on hold don't count toward the limit.
+
+A limit of 0 removes it.
+
+Limits can also be shared with everyone on the project by adding a line like
+this to the card table's description (configured limits take precedence):
+
+  WIP limits: In progress=3, Review=2
+
+Examples:
+  bc4 card column limit 123 3
+  bc4 card column limit 123 0
+  bc4 card column limit https://3.basecamp.com/1234567/buckets/89012345/card_tables/columns/12345 5`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse column ID (could be numeric ID or URL)
+			columnID, parsedURL, err := parser.ParseArgument(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid column ID or URL: %s", args[0])
+			}
+
+			limit, err := strconv.Atoi(args[1])
+			if err != nil || limit < 0 {
+				return fmt.Errorf("invalid limit %q: must be a number of cards, or 0 to remove the limit", args[1])
+			}
+
+			// Apply overrides if specified
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+
+			// If a URL was parsed, override account and project IDs if provided
+			if parsedURL != nil {
+				if parsedURL.ResourceType != parser.ResourceTypeColumn {
+					return fmt.Errorf("URL is not for a column: %s", args[0])
+				}
+				if parsedURL.AccountID > 0 {
+					f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+				}
+				if parsedURL.ProjectID > 0 {
+					f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+				}
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			resolvedAccountID, err := f.AccountID()
+			if err != nil {
+				return err
+			}
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+
+			// Make sure the column exists before storing a limit for it
+			column, err := client.Columns().GetColumn(f.Context(), resolvedProjectID, columnID)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := f.Config()
+			if err != nil {
+				return err
+			}
+			if cfg.Accounts == nil {
+				cfg.Accounts = make(map[string]config.AccountConfig)
+			}
+			acc := cfg.Accounts[resolvedAccountID]
+			if acc.ProjectDefaults == nil {
+				acc.ProjectDefaults = make(map[string]config.ProjectDefaults)
+			}
+			proj := acc.ProjectDefaults[resolvedProjectID]
+			key := strconv.FormatInt(columnID, 10)
+			if limit == 0 {
+				delete(proj.WIPLimits, key)
+			} else {
+				if proj.WIPLimits == nil {
+					proj.WIPLimits = make(map[string]int)
+				}
+				proj.WIPLimits[key] = limit
+			}
+			acc.ProjectDefaults[resolvedProjectID] = proj
+			cfg.Accounts[resolvedAccountID] = acc
+
+			if err := config.Save(cfg); err != nil {
+				return fmt.Errorf("failed to save config: %w", err)
+			}
+
+			if limit == 0 {
+				fmt.Printf("Removed the WIP limit of column '%s'\n", column.Title)
+			} else {
+				fmt.Printf("Column '%s' WIP limit set to %d\n", column.Title, limit)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
@@ -0,0 +1,199 @@
+package card
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// cardSortKeys are the orders 'card column sort' supports
+var cardSortKeys = []string{"due", "assignee", "title", "created"}
+
+func newColumnSortCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var by string
+	var reverse bool
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "sort [COLUMN_ID or URL]",
+		Short: "Reorder the cards in a column",
+		Long: `Reorder every card in a column so its order reflects a rule:
+
+  due       earliest due date first
+  assignee  by the first assignee's name
+  title     alphabetically
+  created   oldest first
+
+Cards without a due date or assignee go to the bottom, and cards that tie
+keep their current order. On-hold cards aren't moved. Only the cards that
+are out of place are moved.
+
+You can specify the column using either:
+- A numeric ID (e.g., "12345")
+- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/card_tables/columns/12345")
+
+Examples:
+  bc4 card column sort 123
+  bc4 card column sort 123 --by assignee
+  bc4 card column sort 123 --by created --reverse
+  bc4 card column sort 123 --by title --dry-run`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse column ID (could be numeric ID or URL)
+			columnID, parsedURL, err := parser.ParseArgument(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid column ID or URL: %s", args[0])
+			}
+
+			by = strings.ToLower(by)
+			if !containsString(cardSortKeys, by) {
+				return fmt.Errorf("invalid sort %q: must be one of %s", by, strings.Join(cardSortKeys, ", "))
+			}
+
+			// Apply overrides if specified
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+
+			// If a URL was parsed, override account and project IDs if provided
+			if parsedURL != nil {
+				if parsedURL.ResourceType != parser.ResourceTypeColumn {
+					return fmt.Errorf("URL is not for a column: %s", args[0])
+				}
+				if parsedURL.AccountID > 0 {
+					f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+				}
+				if parsedURL.ProjectID > 0 {
+					f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+				}
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+			ctx := f.Context()
+
+			column, err := client.Columns().GetColumn(ctx, resolvedProjectID, columnID)
+			if err != nil {
+				return err
+			}
+			cards, err := client.Cards().GetCardsInColumn(ctx, resolvedProjectID, columnID)
+			if err != nil {
+				return fmt.Errorf("failed to get cards in column: %w", err)
+			}
+
+			sorted := sortColumnCards(cards, by, reverse)
+			current := make([]int64, len(cards))
+			for i, card := range cards {
+				current[i] = card.ID
+			}
+			desired := make([]int64, len(sorted))
+			for i, card := range sorted {
+				desired[i] = card.ID
+			}
+			moves := positionMoves(current, desired)
+
+			if len(moves) == 0 {
+				fmt.Printf("Column '%s' is already sorted by %s\n", column.Title, by)
+				return nil
+			}
+
+			if dryRun {
+				fmt.Printf("Would sort column '%s' by %s (%d cards to move):\n", column.Title, by, len(moves))
+				for i, card := range sorted {
+					fmt.Printf("  %d. %s #%d\n", i+1, card.Title, card.ID)
+				}
+				return nil
+			}
+
+			for _, move := range moves {
+				if err := client.Cards().MoveCardToPosition(ctx, resolvedProjectID, move.id, columnID, move.position+1); err != nil {
+					return err
+				}
+			}
+
+			if ui.IsTerminal(os.Stdout) {
+				fmt.Printf("✓ Sorted column '%s' by %s (%d of %d cards moved)\n", column.Title, by, len(moves), len(cards))
+			} else {
+				for _, id := range desired {
+					fmt.Println(id)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&by, "by", "due", "Sort by: "+strings.Join(cardSortKeys, ", "))
+	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse the order (cards missing the value stay at the bottom)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the new order without moving any cards")
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+// sortColumnCards returns the cards in the order given by key. Cards
+// missing the value sort last, and ties keep their current order.
+func sortColumnCards(cards []api.Card, key string, reverse bool) []api.Card {
+	sorted := append([]api.Card(nil), cards...)
+
+	value := func(card api.Card) (string, bool) {
+		switch key {
+		case "due":
+			if card.DueOn == nil || *card.DueOn == "" {
+				return "", false
+			}
+			return *card.DueOn, true
+		case "assignee":
+			if len(card.Assignees) == 0 {
+				return "", false
+			}
+			return strings.ToLower(card.Assignees[0].Name), true
+		case "title":
+			return strings.ToLower(card.Title), true
+		case "created":
+			return card.CreatedAt.UTC().Format("2006-01-02T15:04:05.000000000"), true
+		}
+		return "", false
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, aOK := value(sorted[i])
+		b, bOK := value(sorted[j])
+		if aOK != bOK {
+			return aOK
+		}
+		if reverse {
+			return a > b
+		}
+		return a < b
+	})
+	return sorted
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
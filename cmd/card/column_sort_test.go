@@ -0,0 +1,50 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestSortColumnCards(t *testing.T) {
+	early, late := "2025-03-01", "2025-04-01"
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	cards := []api.Card{
+		{ID: 1, Title: "delta", CreatedAt: day(4)},
+		{ID: 2, Title: "Bravo", DueOn: &late, Assignees: []api.Person{{Name: "Sam"}}, CreatedAt: day(2)},
+		{ID: 3, Title: "charlie", CreatedAt: day(3)},
+		{ID: 4, Title: "Alpha", DueOn: &early, Assignees: []api.Person{{Name: "jane"}}, CreatedAt: day(1)},
+	}
+	ids := func(cards []api.Card) []int64 {
+		out := make([]int64, len(cards))
+		for i, card := range cards {
+			out[i] = card.ID
+		}
+		return out
+	}
+
+	tests := []struct {
+		key     string
+		reverse bool
+		want    []int64
+	}{
+		{key: "due", want: []int64{4, 2, 1, 3}},
+		{key: "due", reverse: true, want: []int64{2, 4, 1, 3}},
+		{key: "assignee", want: []int64{4, 2, 1, 3}},
+		{key: "title", want: []int64{4, 2, 3, 1}},
+		{key: "created", want: []int64{4, 2, 3, 1}},
+		{key: "created", reverse: true, want: []int64{1, 3, 2, 4}},
+	}
+	for _, tt := range tests {
+		name := tt.key
+		if tt.reverse {
+			name += " reversed"
+		}
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ids(sortColumnCards(cards, tt.key, tt.reverse)))
+		})
+	}
+}
@@ -14,4 +14,21 @@ const (
 
 	// Format constants
 	formatJSON = "json"
+
+	// fetchConcurrency limits parallel requests when reading every card of
+	// a table
+	fetchConcurrency = 4
 )
+
+// Column types as reported by the Basecamp API
+const (
+	columnTypeTriage = "Kanban::Triage"
+	columnTypeDone   = "Kanban::DoneColumn"
+	columnTypeNotNow = "Kanban::NotNowColumn"
+)
+
+// isWorkColumnType reports whether cards in a column of this type are being
+// worked on, as opposed to waiting in triage, parked or done
+func isWorkColumnType(columnType string) bool {
+	return columnType != columnTypeTriage && columnType != columnTypeDone && columnType != columnTypeNotNow
+}
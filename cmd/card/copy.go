@@ -0,0 +1,94 @@
+package card
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/spf13/cobra"
+)
+
+func newCopyCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	opts := &transferOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "copy [ID or URL]",
+		Short: "Copy a card to another card table or project",
+		Long: `Copy a card to a card table in this or another project.
+
+The copy gets the card's title, content, due date, assignees and steps (with
+their completion state). Use --with-comments to bring the comments along as
+quoted history. When copying to another project, attachments are uploaded
+again and assignees are matched by email address; anyone without access to
+the destination project is left unassigned with a warning.
+
+The original and the copy each get a comment linking to the other. Use
+--archive to archive the original afterwards, or 'bc4 card move --to-table'
+to do both in one go.
+
+Without --to-table the destination project's default card table is used.
+Without --column the card goes to the column with the same name as its
+current one, or the first column.`,
+		Example: `  # Copy a card to another card table in the project
+  bc4 card copy 12345 --to-table "Sprint 12"
+
+  # Copy to another project, into a specific column, with comments
+  bc4 card copy 12345 --to-project "Website Redesign" --column "Triage" --with-comments
+
+  # Copy and archive the original
+  bc4 card copy 12345 --to-table https://3.basecamp.com/1234567/buckets/89012345/card_tables/67890 --archive`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse card ID (could be numeric ID or URL)
+			cardID, parsedURL, err := parser.ParseArgument(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid card ID or URL: %s", args[0])
+			}
+
+			// Apply overrides if specified
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+
+			// If a URL was parsed, override account and project IDs if provided
+			if parsedURL != nil {
+				if parsedURL.ResourceType != parser.ResourceTypeCard {
+					return fmt.Errorf("URL is not for a card: %s", args[0])
+				}
+				if parsedURL.AccountID > 0 {
+					f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+				}
+				if parsedURL.ProjectID > 0 {
+					f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+				}
+			}
+
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+
+			return runCardTransfer(f, client, resolvedProjectID, cardID, opts, false)
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVar(&opts.toTable, "to-table", "", "Destination card table ID, name, or URL")
+	cmd.Flags().StringVar(&opts.toProject, "to-project", "", "Destination project ID, name, or URL")
+	cmd.Flags().StringVar(&opts.column, "column", "", "Destination column name or ID")
+	cmd.Flags().BoolVar(&opts.withComments, "with-comments", false, "Copy comments as quoted history")
+	cmd.Flags().BoolVar(&opts.archive, "archive", false, "Archive the original card after copying")
+
+	return cmd
+}
@@ -11,8 +11,11 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cardtemplate"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -84,8 +87,10 @@ type createModel struct {
 	spinner           spinner.Model
 	selectedColumn    *api.Column
 	selectedAssignees []int64
+	notify            bool
 	cardTitle         string
 	cardContent       string
+	dueOn             *string
 	createdCard       *api.Card
 	err               error
 	width             int
@@ -131,8 +136,11 @@ func (m createModel) createCard() tea.Cmd {
 		}
 
 		req := api.CardCreateRequest{
-			Title:   m.cardTitle,
-			Content: richContent,
+			Title:       m.cardTitle,
+			Content:     richContent,
+			DueOn:       m.dueOn,
+			AssigneeIDs: m.selectedAssignees,
+			Notify:      m.notify,
 		}
 
 		card, err := m.client.CreateCard(m.factory.Context(), m.projectID, m.selectedColumn.ID, req)
@@ -140,20 +148,6 @@ func (m createModel) createCard() tea.Cmd {
 			return cardCreatedMsg{err: err}
 		}
 
-		// If assignees were selected, update the card to add them
-		if len(m.selectedAssignees) > 0 {
-			updateReq := api.CardUpdateRequest{
-				Title:       m.cardTitle,
-				Content:     richContent,
-				AssigneeIDs: m.selectedAssignees,
-			}
-			card, err = m.client.UpdateCard(m.factory.Context(), m.projectID, card.ID, updateReq)
-			if err != nil {
-				// Card was created but assigning failed
-				return cardCreatedMsg{card: card, err: fmt.Errorf("card created but failed to assign: %w", err)}
-			}
-		}
-
 		return cardCreatedMsg{card: card}
 	}
 }
@@ -256,7 +250,7 @@ func (m createModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					// Setup people list
 					items := make([]list.Item, len(m.people))
 					for i, person := range m.people {
-						items[i] = personItem{person: person, selected: false}
+						items[i] = personItem{person: person, selected: containsID(m.selectedAssignees, person.ID)}
 					}
 					m.peopleList.SetItems(items)
 					m.step = stepSelectAssignees
@@ -380,20 +374,71 @@ func newCreateCmd(f *factory.Factory) *cobra.Command {
 	var columnID string
 	var accountID string
 	var projectID string
+	var notify bool
+	var subscribe []string
+	var template string
+	var dueOn string
+	var assignees []string
+	var steps []string
+	var onHold bool
 
 	cmd := &cobra.Command{
-		Use:   "create",
+		Use:   "create [title]",
 		Short: "Create a new card interactively",
 		Long: `Create a new card using an interactive interface.
 
 If you specify a card table ID, the interactive UI will start from column selection.
 If you also specify a column ID, it will skip to entering card details.
 
+Giving a title creates the card straight away without the interactive UI,
+in the column given with --column or the first column after Triage. With
+--template the card starts from a card template, including its content,
+assignees, due date and steps; see 'bc4 card template' for the template
+format.
+
+--due, --assign, --step, --notify, --subscribe and --on-hold work in both
+modes; in the interactive UI the people given with --assign start out
+selected. --notify lets the assignees know about the new card.
+
 Examples:
   bc4 card create                      # Full interactive mode
   bc4 card create --table 123          # Start from column selection in table 123  
-  bc4 card create --table 123 --column 456  # Skip to card details for column 456`,
+  bc4 card create --table 123 --column 456  # Skip to card details for column 456
+  bc4 card create --assign @jane --notify  # Assign Jane and notify her
+  bc4 card create --subscribe @jane        # Subscribe Jane once the card is created
+  bc4 card create "Renew domain" --column 456    # Create a card without the interactive UI
+  bc4 card create "Renew domain" --due +2w --assign @jane --step "Check invoice"
+  bc4 card create "Waiting on legal" --column 456 --on-hold
+  bc4 card create --template bug "Login broken"  # Create a card from the "bug" template`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if template != "" || len(args) > 0 {
+				title := ""
+				if len(args) > 0 {
+					title = args[0]
+				}
+				opts := &addOptions{
+					accountID: accountID,
+					projectID: projectID,
+					tableID:   cardTableID,
+					assignees: assignees,
+					steps:     steps,
+					dueOn:     dueOn,
+					notify:    notify,
+					subscribe: subscribe,
+					template:  template,
+					onHold:    onHold,
+				}
+				if columnID != "" {
+					id, err := strconv.ParseInt(columnID, 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid column ID: %s", columnID)
+					}
+					opts.columnID = id
+				}
+				return runAdd(f, opts, title)
+			}
+
 			// Apply overrides if specified
 			f = f.ApplyOverrides(accountID, projectID)
 
@@ -425,6 +470,35 @@ Examples:
 				tableID = cardTable.ID
 			}
 
+			// Resolve people and dates up front so typos fail before the UI starts
+			loc, err := f.Location()
+			if err != nil {
+				return err
+			}
+			userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+			var subscribeIDs []int64
+			if len(subscribe) > 0 {
+				subscribeIDs, err = userResolver.ResolveUsers(f.Context(), subscribe)
+				if err != nil {
+					return fmt.Errorf("failed to resolve people to subscribe: %w", err)
+				}
+			}
+			var assigneeIDs []int64
+			if len(assignees) > 0 {
+				assigneeIDs, err = userResolver.ResolveUsers(f.Context(), assignees)
+				if err != nil {
+					return fmt.Errorf("failed to resolve assignees: %w", err)
+				}
+			}
+			var due *string
+			if dueOn != "" {
+				resolved, err := cmdutil.ResolveDate("Due date", dueOn, loc)
+				if err != nil {
+					return err
+				}
+				due = &resolved
+			}
+
 			// Initialize the model
 			model := createModel{
 				factory:      f,
@@ -435,7 +509,10 @@ Examples:
 				spinner:      spinner.New(),
 				titleInput:   textinput.New(),
 				contentInput: textinput.New(),
+				dueOn:        due,
+				notify:       notify,
 			}
+			model.selectedAssignees = assigneeIDs
 
 			// Configure inputs
 			model.titleInput.Placeholder = "Enter card title..."
@@ -481,6 +558,31 @@ Examples:
 				}
 				if m.createdCard != nil {
 					fmt.Printf("#%d\n", m.createdCard.ID)
+
+					if len(subscribeIDs) > 0 {
+						_, err := client.Subscriptions().UpdateSubscription(f.Context(), resolvedProjectID, m.createdCard.ID, api.SubscriptionUpdateRequest{
+							Subscriptions: subscribeIDs,
+						})
+						if err != nil {
+							fmt.Printf("Warning: failed to subscribe people: %v\n", err)
+						}
+					}
+
+					var cardSteps []cardtemplate.Step
+					for _, title := range steps {
+						cardSteps = append(cardSteps, cardtemplate.Step{Title: title})
+					}
+					addCardSteps(f, client, userResolver, resolvedProjectID, m.createdCard.ID, cardSteps, loc)
+
+					if onHold {
+						column := m.selectedColumn
+						for i := range m.columns {
+							if m.columns[i].ID == column.ID {
+								column = &m.columns[i]
+							}
+						}
+						holdCard(f, client, resolvedProjectID, m.createdCard.ID, column)
+					}
 				}
 			}
 
@@ -489,9 +591,16 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&cardTableID, "table", "", "Card table ID")
-	cmd.Flags().StringVar(&columnID, "column", "", "Column ID (requires --table)")
+	cmd.Flags().StringVar(&columnID, "column", "", "Column ID")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().BoolVar(&notify, "notify", false, "Notify the assignees about the new card")
+	cmd.Flags().StringSliceVar(&subscribe, "subscribe", nil, "Subscribe people to the card (by email or @mention)")
+	cmd.Flags().StringVarP(&template, "template", "t", "", "Create the card from a card template (name or path) without the interactive UI")
+	cmd.Flags().StringVar(&dueOn, "due", "", "Set due date (YYYY-MM-DD, or e.g. today, fri, next monday, +3d)")
+	cmd.Flags().StringSliceVar(&assignees, "assign", nil, "Assign people by email or @mention (comma-separated)")
+	cmd.Flags().StringSliceVar(&steps, "step", nil, "Add steps (can be used multiple times)")
+	cmd.Flags().BoolVar(&onHold, "on-hold", false, "Put the card on hold in its column")
 
 	return cmd
 }
@@ -0,0 +1,262 @@
+package card
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cardexport"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/tui"
+)
+
+func newExportCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var format string
+	var output string
+	var noComments bool
+
+	cmd := &cobra.Command{
+		Use:   "export [table]",
+		Short: "Export a card table with all its cards, steps and comments",
+		Long: `Export a card table in full: its columns (with their colors), every card
+including those on hold, and each card's content, due date, assignees, steps
+and comments.
+
+JSON exports can be imported again with 'bc4 card import', into the same
+project or another one. CSV exports have one row per card, with steps and
+comments listed one per line in their cells, for spreadsheets and reports.`,
+		Example: `  # Export the default card table as JSON
+  bc4 card export > roadmap.json
+
+  # Export a table by name as CSV
+  bc4 card export "Product roadmap" --format csv -o roadmap.csv
+
+  # Skip comments for a quicker export
+  bc4 card export --no-comments -o roadmap.json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format = strings.ToLower(format)
+			if format != "json" && format != "csv" {
+				return fmt.Errorf("invalid format %q: must be json or csv", format)
+			}
+
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+			identifier := ""
+			if len(args) > 0 {
+				identifier = args[0]
+			}
+			f, err := withCardTableURL(f, identifier)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+			cardTable, err := resolveCardTable(f, client, resolvedProjectID, identifier)
+			if err != nil {
+				return err
+			}
+
+			table, columns, err := tui.LoadBoard(f.Context(), client.Client, resolvedProjectID, cardTable.ID)
+			if err != nil {
+				return err
+			}
+			cards, comments, err := fetchCardDetails(f, client, resolvedProjectID, columns, !noComments)
+			if err != nil {
+				return err
+			}
+			board := buildExport(table, columns, cards, comments, time.Now())
+
+			var w io.Writer = os.Stdout
+			if output != "" && output != "-" {
+				file, err := os.Create(output)
+				if err != nil {
+					return fmt.Errorf("failed to create output file: %w", err)
+				}
+				defer func() { _ = file.Close() }()
+				w = file
+			}
+
+			if format == "csv" {
+				converter := markdown.NewConverter()
+				err = cardexport.WriteCSV(w, board, func(content string) string {
+					md, err := converter.RichTextToMarkdown(content)
+					if err != nil {
+						return content
+					}
+					return strings.TrimSpace(md)
+				})
+			} else {
+				encoder := json.NewEncoder(w)
+				encoder.SetIndent("", "  ")
+				err = encoder.Encode(board)
+			}
+			if err != nil {
+				return err
+			}
+
+			if output != "" && output != "-" {
+				fmt.Fprintf(os.Stderr, "✓ Exported %d columns and %d cards to %s\n", len(board.Columns), board.CardCount(), output)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVarP(&format, "format", "f", "json", "Output format: json or csv")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Write to a file instead of stdout")
+	cmd.Flags().BoolVar(&noComments, "no-comments", false, "Leave comments out of the export")
+
+	return cmd
+}
+
+// fetchCardDetails loads every card in full, with its steps, and optionally
+// its comments
+func fetchCardDetails(f *factory.Factory, client *api.ModularClient, projectID string, columns []tui.BoardColumn, withComments bool) (map[int64]*api.Card, map[int64][]api.Comment, error) {
+	var ids []int64
+	for _, column := range columns {
+		for _, card := range column.Cards {
+			ids = append(ids, card.ID)
+		}
+	}
+
+	cards := make([]*api.Card, len(ids))
+	cardComments := make([][]api.Comment, len(ids))
+	limiter := api.GetRateLimiter()
+	g, ctx := errgroup.WithContext(f.Context())
+	g.SetLimit(fetchConcurrency)
+	for i, id := range ids {
+		g.Go(func() error {
+			limiter.Wait()
+			card, err := client.Cards().GetCard(ctx, projectID, id)
+			if err != nil {
+				return fmt.Errorf("failed to fetch card #%d: %w", id, err)
+			}
+			cards[i] = card
+			if withComments && card.CommentsCount > 0 {
+				limiter.Wait()
+				comments, err := client.Comments().ListComments(ctx, projectID, id)
+				if err != nil {
+					return fmt.Errorf("failed to fetch comments of card #%d: %w", id, err)
+				}
+				cardComments[i] = comments
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	byID := make(map[int64]*api.Card, len(ids))
+	commentsByID := make(map[int64][]api.Comment, len(ids))
+	for i, id := range ids {
+		byID[id] = cards[i]
+		commentsByID[id] = cardComments[i]
+	}
+	return byID, commentsByID, nil
+}
+
+// buildExport assembles the export of a card table. Cards missing from
+// details fall back to what the column listing returned.
+func buildExport(table *api.CardTable, columns []tui.BoardColumn, details map[int64]*api.Card, comments map[int64][]api.Comment, now time.Time) *cardexport.Board {
+	board := &cardexport.Board{
+		Version:     cardexport.Version,
+		Source:      "bc4",
+		Title:       table.Title,
+		Description: table.Description,
+		URL:         table.URL,
+		ExportedAt:  now.UTC().Truncate(time.Second),
+	}
+
+	for _, column := range columns {
+		exported := cardexport.Column{
+			Title: column.Column.Title,
+			Type:  column.Column.Type,
+			Color: column.Column.Color,
+			Cards: []cardexport.Card{},
+		}
+
+		for _, listed := range column.Cards {
+			card := &listed
+			if full, ok := details[listed.ID]; ok && full != nil {
+				card = full
+			}
+
+			out := cardexport.Card{
+				ID:        card.ID,
+				Title:     card.Title,
+				Content:   card.Content,
+				OnHold:    listed.IsOnHold,
+				Assignees: exportPeople(card.Assignees),
+				CreatedAt: card.CreatedAt,
+				URL:       card.AppURL,
+			}
+			if card.DueOn != nil {
+				out.DueOn = *card.DueOn
+			}
+			if card.Creator != nil {
+				creator := exportPerson(*card.Creator)
+				out.Creator = &creator
+			}
+			for _, step := range card.Steps {
+				s := cardexport.Step{
+					Title:     step.Title,
+					Completed: step.Completed,
+					Assignees: exportPeople(step.Assignees),
+				}
+				if step.DueOn != nil {
+					s.DueOn = *step.DueOn
+				}
+				out.Steps = append(out.Steps, s)
+			}
+			for _, comment := range comments[card.ID] {
+				out.Comments = append(out.Comments, cardexport.Comment{
+					Author:    exportPerson(comment.Creator),
+					CreatedAt: comment.CreatedAt,
+					Content:   comment.Content,
+				})
+			}
+
+			exported.Cards = append(exported.Cards, out)
+		}
+
+		board.Columns = append(board.Columns, exported)
+	}
+
+	return board
+}
+
+func exportPerson(p api.Person) cardexport.Person {
+	return cardexport.Person{Name: p.Name, Email: p.EmailAddress}
+}
+
+func exportPeople(people []api.Person) []cardexport.Person {
+	var out []cardexport.Person
+	for _, p := range people {
+		out = append(out, exportPerson(p))
+	}
+	return out
+}
@@ -0,0 +1,441 @@
+package card
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cardexport"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/tui"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+type importOptions struct {
+	accountID  string
+	projectID  string
+	table      string
+	dryRun     bool
+	noComments bool
+}
+
+// importColumn pairs a column from the file with the column its cards go
+// into. column is nil when the column still has to be created.
+type importColumn struct {
+	source   cardexport.Column
+	column   *api.Column
+	create   []cardexport.Card
+	existing []cardexport.Card
+}
+
+func newImportCmd(f *factory.Factory) *cobra.Command {
+	opts := &importOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import columns and cards from a card table or Trello export",
+		Long: `Recreate columns and cards in a card table from a file written by
+'bc4 card export', or from a Trello board exported as JSON. Use "-" to read
+from stdin.
+
+Columns are matched by name, and the Triage, Done and Not Now columns by
+their kind; columns that don't exist yet are created with their color. Cards
+are created with their content, due date, assignees, steps and comments, and
+cards that were on hold are put on hold again. Comments are posted by you,
+quoting their original author and date.
+
+Files attached to cards and comments from another project are uploaded
+again, since Basecamp attachments belong to the project they were posted in;
+any that can't be downloaded are left as they are with a warning.
+
+Assignees are looked up by email address, or by name for Trello exports;
+people who aren't on the project are left unassigned with a warning.
+
+For Trello boards, lists become columns, checklists become steps and labels
+are listed at the end of each card's content. Archived lists and cards are
+left out.
+
+Importing is idempotent: cards whose title already exists in the same column
+are skipped, so an import can be re-run after it was interrupted.`,
+		Example: `  # Copy a card table to another project
+  bc4 card export Roadmap -o roadmap.json
+  bc4 card import roadmap.json --project "New project" --table Roadmap
+
+  # Preview importing a Trello board
+  bc4 card import trello-board.json --dry-run
+
+  # Import without comments
+  bc4 card import roadmap.json --no-comments`,
+		Args: cmdutil.ExactArgs(1, "file"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCardImport(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVarP(&opts.table, "table", "t", "", "Card table ID, name, or URL (defaults to the project's card table)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show what would be created without making changes")
+	cmd.Flags().BoolVar(&opts.noComments, "no-comments", false, "Don't import comments")
+
+	return cmd
+}
+
+func runCardImport(f *factory.Factory, opts *importOptions, path string) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		r = file
+	}
+
+	board, err := cardexport.Decode(r)
+	if err != nil {
+		return err
+	}
+	if len(board.Columns) == 0 {
+		return fmt.Errorf("no columns found in %s", path)
+	}
+
+	if opts.accountID != "" {
+		f = f.WithAccount(opts.accountID)
+	}
+	if opts.projectID != "" {
+		f = f.WithProject(opts.projectID)
+	}
+	f, err = withCardTableURL(f, opts.table)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+	cardTable, err := resolveCardTable(f, client, projectID, opts.table)
+	if err != nil {
+		return err
+	}
+
+	ctx := f.Context()
+	table, columns, err := tui.LoadBoard(ctx, client.Client, projectID, cardTable.ID)
+	if err != nil {
+		return err
+	}
+
+	plan := planCardImport(board, columns)
+
+	// Resolve everyone up front so the dry run shows who will be unassigned
+	userResolver := utils.NewUserResolver(client.Client, projectID)
+	people := make(map[string]int64)
+	for _, ic := range plan {
+		for _, card := range ic.create {
+			for _, person := range importPeople(card) {
+				key := person.Identifier()
+				if _, ok := people[key]; ok || key == "" {
+					continue
+				}
+				ids, err := userResolver.ResolveUsers(ctx, []string{key})
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: %s isn't on this project and will be left unassigned\n", person.Name)
+					people[key] = 0
+					continue
+				}
+				people[key] = ids[0]
+			}
+		}
+	}
+	resolve := func(persons []cardexport.Person) []int64 {
+		var ids []int64
+		for _, person := range persons {
+			if id := people[person.Identifier()]; id != 0 {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+
+	if opts.dryRun {
+		printCardImportPlan(plan, table.Title, !opts.noComments)
+		return nil
+	}
+
+	// Attachments belong to the project they were posted in, so files from
+	// another project's export are uploaded again
+	sourceProjectID := exportProjectID(board)
+	content := func(content, label string) string {
+		if sourceProjectID == projectID {
+			return content
+		}
+		rehosted, errs := utils.RehostAttachments(ctx, client.Uploads(), client.Attachments(), sourceProjectID, content)
+		for _, err := range errs {
+			fmt.Fprintf(os.Stderr, "Warning: attachment on %s not copied: %v\n", label, err)
+		}
+		return rehosted
+	}
+
+	columnOps := client.Columns()
+	cardOps := client.Cards()
+	tty := ui.IsTerminal(os.Stdout)
+	created, newColumns, skipped := 0, 0, 0
+
+	for _, ic := range plan {
+		skipped += len(ic.existing)
+
+		if ic.column == nil {
+			req := api.ColumnCreateRequest{Title: ic.source.Title}
+			if color, err := utils.ValidateColor(ic.source.Color); err == nil {
+				req.Color = color
+			}
+			column, err := columnOps.CreateColumn(ctx, projectID, table.ID, req)
+			if err != nil {
+				return err
+			}
+			ic.column = column
+			newColumns++
+		}
+
+		onHoldID := ic.column.OnHold.ID
+		if onHoldID == 0 && anyOnHold(ic.create) {
+			if err := columnOps.SetColumnOnHold(ctx, projectID, ic.column.ID); err != nil {
+				return err
+			}
+			column, err := columnOps.GetColumn(ctx, projectID, ic.column.ID)
+			if err != nil {
+				return err
+			}
+			onHoldID = column.OnHold.ID
+		}
+
+		for _, card := range ic.create {
+			label := fmt.Sprintf("card %q", card.Title)
+			req := api.CardCreateRequest{Title: card.Title, Content: content(card.Content, label)}
+			if card.DueOn != "" {
+				due := card.DueOn
+				req.DueOn = &due
+			}
+			newCard, err := cardOps.CreateCard(ctx, projectID, ic.column.ID, req)
+			if err != nil {
+				return fmt.Errorf("failed to create card %q: %w", card.Title, err)
+			}
+
+			if assignees := resolve(card.Assignees); len(assignees) > 0 {
+				if _, err := cardOps.UpdateCard(ctx, projectID, newCard.ID, api.CardUpdateRequest{AssigneeIDs: assignees}); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to assign card %q: %v\n", card.Title, err)
+				}
+			}
+
+			for _, step := range card.Steps {
+				stepReq := api.StepCreateRequest{Title: step.Title}
+				if step.DueOn != "" {
+					due := step.DueOn
+					stepReq.DueOn = &due
+				}
+				if ids := resolve(step.Assignees); len(ids) > 0 {
+					stepReq.Assignees = formatIDs(ids)
+				}
+				newStep, err := client.Steps().CreateStep(ctx, projectID, newCard.ID, stepReq)
+				if err != nil {
+					return fmt.Errorf("failed to create step %q on card %q: %w", step.Title, card.Title, err)
+				}
+				if step.Completed {
+					if err := client.Steps().SetStepCompletion(ctx, projectID, newStep.ID, true); err != nil {
+						fmt.Fprintf(os.Stderr, "Warning: failed to complete step %q: %v\n", step.Title, err)
+					}
+				}
+			}
+
+			if !opts.noComments {
+				for _, comment := range card.Comments {
+					quoted := api.Comment{Creator: api.Person{Name: comment.Author.Name}, CreatedAt: comment.CreatedAt}
+					req := api.CommentCreateRequest{Content: utils.QuoteComment(quoted, content(comment.Content, "a comment on "+label))}
+					if _, err := client.Comments().CreateComment(ctx, projectID, newCard.ID, req); err != nil {
+						fmt.Fprintf(os.Stderr, "Warning: failed to import a comment on card %q: %v\n", card.Title, err)
+					}
+				}
+			}
+
+			if card.OnHold && onHoldID != 0 {
+				if err := cardOps.MoveCard(ctx, projectID, newCard.ID, onHoldID); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to put card %q on hold: %v\n", card.Title, err)
+				}
+			}
+
+			created++
+			if !tty {
+				fmt.Println(newCard.ID)
+			}
+		}
+	}
+
+	if tty {
+		fmt.Printf("✓ Imported %d cards into %s", created, table.Title)
+		if newColumns > 0 {
+			fmt.Printf(" (%d new columns)", newColumns)
+		}
+		fmt.Println()
+		if skipped > 0 {
+			fmt.Printf("  Skipped %d cards that already exist\n", skipped)
+		}
+	}
+
+	return nil
+}
+
+// exportProjectID returns the project a bc4 export was taken from, or ""
+// when the file doesn't say (e.g. Trello boards)
+func exportProjectID(board *cardexport.Board) string {
+	if _, parsed, err := parser.ParseArgument(board.URL); err == nil && parsed != nil && parsed.ProjectID > 0 {
+		return strconv.FormatInt(parsed.ProjectID, 10)
+	}
+	return ""
+}
+
+// planCardImport matches the columns of an export to those of the card
+// table and works out which cards need creating. The Triage, Done and Not
+// Now columns are matched by type, other columns by title. Source columns
+// that land in the same column are merged.
+func planCardImport(board *cardexport.Board, columns []tui.BoardColumn) []*importColumn {
+	var plan []*importColumn
+	targets := make(map[string]*importColumn)
+	titles := make(map[*importColumn]map[string]bool)
+
+	for _, source := range board.Columns {
+		column := matchImportColumn(source, columns)
+		key := "new:" + strings.ToLower(strings.TrimSpace(source.Title))
+		if column != nil {
+			key = fmt.Sprintf("%d", column.Column.ID)
+		}
+
+		ic, ok := targets[key]
+		if !ok {
+			ic = &importColumn{source: source}
+			seen := make(map[string]bool)
+			if column != nil {
+				ic.column = &column.Column
+				for _, card := range column.Cards {
+					seen[cardImportKey(card.Title)] = true
+				}
+			}
+			targets[key] = ic
+			titles[ic] = seen
+			plan = append(plan, ic)
+		}
+
+		for _, card := range source.Cards {
+			if titles[ic][cardImportKey(card.Title)] {
+				ic.existing = append(ic.existing, card)
+				continue
+			}
+			titles[ic][cardImportKey(card.Title)] = true
+			ic.create = append(ic.create, card)
+		}
+	}
+
+	return plan
+}
+
+// matchImportColumn finds the column of the card table a column from an
+// export belongs in
+func matchImportColumn(source cardexport.Column, columns []tui.BoardColumn) *tui.BoardColumn {
+	if source.Type != "" && !isWorkColumnType(source.Type) {
+		for i := range columns {
+			if columns[i].Column.Type == source.Type {
+				return &columns[i]
+			}
+		}
+	}
+	for i := range columns {
+		if strings.EqualFold(strings.TrimSpace(columns[i].Column.Title), strings.TrimSpace(source.Title)) {
+			return &columns[i]
+		}
+	}
+	return nil
+}
+
+// cardImportKey identifies a card title within a column for duplicate
+// detection
+func cardImportKey(title string) string {
+	return strings.ToLower(strings.TrimSpace(title))
+}
+
+func anyOnHold(cards []cardexport.Card) bool {
+	for _, card := range cards {
+		if card.OnHold {
+			return true
+		}
+	}
+	return false
+}
+
+// importPeople returns everyone a card and its steps are assigned to
+func importPeople(card cardexport.Card) []cardexport.Person {
+	people := append([]cardexport.Person(nil), card.Assignees...)
+	for _, step := range card.Steps {
+		people = append(people, step.Assignees...)
+	}
+	return people
+}
+
+func printCardImportPlan(plan []*importColumn, tableName string, withComments bool) {
+	fmt.Printf("Would import into %s:\n", tableName)
+	toCreate, existing := 0, 0
+	for _, ic := range plan {
+		if ic.column == nil {
+			fmt.Printf("  + column %q\n", ic.source.Title)
+		} else {
+			fmt.Printf("  = column %q\n", ic.column.Title)
+		}
+		for _, card := range ic.create {
+			fmt.Printf("    + card %q%s\n", card.Title, describeImportCard(card, withComments))
+		}
+		for _, card := range ic.existing {
+			fmt.Printf("    = card %q (already exists)\n", card.Title)
+		}
+		toCreate += len(ic.create)
+		existing += len(ic.existing)
+	}
+	fmt.Printf("\n%d to create, %d already exist\n", toCreate, existing)
+}
+
+func describeImportCard(card cardexport.Card, withComments bool) string {
+	var parts []string
+	if card.DueOn != "" {
+		parts = append(parts, "due "+card.DueOn)
+	}
+	if len(card.Assignees) > 0 {
+		var names []string
+		for _, person := range card.Assignees {
+			names = append(names, person.Name)
+		}
+		parts = append(parts, strings.Join(names, ", "))
+	}
+	if len(card.Steps) > 0 {
+		parts = append(parts, fmt.Sprintf("%d steps", len(card.Steps)))
+	}
+	if withComments && len(card.Comments) > 0 {
+		parts = append(parts, fmt.Sprintf("%d comments", len(card.Comments)))
+	}
+	if card.OnHold {
+		parts = append(parts, "on hold")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, "; ") + ")"
+}
@@ -0,0 +1,101 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cardexport"
+	"github.com/needmore/bc4/internal/tui"
+)
+
+func TestPlanCardImport(t *testing.T) {
+	columns := []tui.BoardColumn{
+		{Column: api.Column{ID: 1, Title: "Inbox", Type: columnTypeTriage}},
+		{Column: api.Column{ID: 2, Title: "Doing", Type: "Kanban::Column"}, Cards: []api.Card{{Title: "Existing"}}},
+		{Column: api.Column{ID: 3, Title: "Finished", Type: columnTypeDone}},
+	}
+	board := &cardexport.Board{Columns: []cardexport.Column{
+		{Title: "Triage", Type: columnTypeTriage, Cards: []cardexport.Card{{Title: "New idea"}}},
+		{Title: "doing", Cards: []cardexport.Card{{Title: "existing "}, {Title: "Fresh"}, {Title: "Fresh"}}},
+		{Title: "Review", Color: "purple", Cards: []cardexport.Card{{Title: "Check"}}},
+		{Title: "Done", Type: columnTypeDone},
+		{Title: "Review", Cards: []cardexport.Card{{Title: "Second check"}}},
+	}}
+
+	plan := planCardImport(board, columns)
+	require.Len(t, plan, 4)
+
+	assert.Equal(t, int64(1), plan[0].column.ID, "triage matched by type")
+	assert.Len(t, plan[0].create, 1)
+
+	assert.Equal(t, int64(2), plan[1].column.ID, "matched by title, ignoring case")
+	assert.Equal(t, []cardexport.Card{{Title: "Fresh"}}, plan[1].create)
+	assert.Len(t, plan[1].existing, 2, "existing titles and repeats are skipped")
+
+	assert.Nil(t, plan[2].column, "missing columns are created")
+	assert.Equal(t, "purple", plan[2].source.Color)
+	assert.Len(t, plan[2].create, 2, "columns with the same title are merged")
+
+	assert.Equal(t, int64(3), plan[3].column.ID, "done matched by type")
+	assert.Empty(t, plan[3].create)
+}
+
+func TestBuildExport(t *testing.T) {
+	due := "2025-04-01"
+	created := time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC)
+	jane := api.Person{ID: 7, Name: "Jane", EmailAddress: "jane@example.com"}
+
+	table := &api.CardTable{ID: 10, Title: "Roadmap", URL: "https://example.com/table"}
+	columns := []tui.BoardColumn{
+		{
+			Column: api.Column{ID: 1, Title: "Doing", Type: "Kanban::Column", Color: "blue"},
+			Cards: []api.Card{
+				{ID: 100, Title: "Listed title"},
+				{ID: 101, Title: "Held", IsOnHold: true},
+			},
+		},
+		{Column: api.Column{ID: 2, Title: "Done", Type: columnTypeDone}},
+	}
+	details := map[int64]*api.Card{
+		100: {
+			ID: 100, Title: "Full title", Content: "<div>Body</div>", DueOn: &due,
+			Assignees: []api.Person{jane}, Creator: &jane, CreatedAt: created, AppURL: "https://example.com/card",
+			Steps: []api.Step{{Title: "Build", Completed: true, DueOn: &due, Assignees: []api.Person{jane}}},
+		},
+	}
+	comments := map[int64][]api.Comment{
+		100: {{Creator: jane, CreatedAt: created, Content: "<div>Hi</div>"}},
+	}
+	now := time.Date(2025, 3, 1, 12, 0, 0, 500, time.FixedZone("X", 3600))
+
+	board := buildExport(table, columns, details, comments, now)
+
+	assert.Equal(t, cardexport.Version, board.Version)
+	assert.Equal(t, "bc4", board.Source)
+	assert.Equal(t, "Roadmap", board.Title)
+	assert.Equal(t, time.Date(2025, 3, 1, 11, 0, 0, 0, time.UTC), board.ExportedAt)
+	require.Len(t, board.Columns, 2)
+	assert.Equal(t, "blue", board.Columns[0].Color)
+	assert.NotNil(t, board.Columns[1].Cards, "empty columns export an empty list")
+
+	cards := board.Columns[0].Cards
+	require.Len(t, cards, 2)
+	person := cardexport.Person{Name: "Jane", Email: "jane@example.com"}
+	assert.Equal(t, cardexport.Card{
+		ID: 100, Title: "Full title", Content: "<div>Body</div>", DueOn: due,
+		Assignees: []cardexport.Person{person},
+		Steps:     []cardexport.Step{{Title: "Build", Completed: true, DueOn: due, Assignees: []cardexport.Person{person}}},
+		Comments:  []cardexport.Comment{{Author: person, CreatedAt: created, Content: "<div>Hi</div>"}},
+		Creator:   &person, CreatedAt: created, URL: "https://example.com/card",
+	}, cards[0])
+	assert.Equal(t, cardexport.Card{ID: 101, Title: "Held", OnHold: true}, cards[1], "falls back to the listing")
+}
+
+func TestExportProjectID(t *testing.T) {
+	assert.Equal(t, "89012345", exportProjectID(&cardexport.Board{URL: "https://3.basecamp.com/1234567/buckets/89012345/card_tables/555"}))
+	assert.Equal(t, "", exportProjectID(&cardexport.Board{Source: "trello"}))
+}
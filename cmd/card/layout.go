@@ -0,0 +1,339 @@
+package card
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// tableLayout is the desired set of columns of a card table, read from a
+// layout file
+type tableLayout struct {
+	Columns []layoutColumn `yaml:"columns"`
+}
+
+// layoutColumn describes one column. Fields left out of the file are left
+// as they are on the card table.
+type layoutColumn struct {
+	Title       string  `yaml:"title"`
+	RenamedFrom string  `yaml:"renamed_from,omitempty"`
+	Description *string `yaml:"description,omitempty"`
+	Color       string  `yaml:"color,omitempty"`
+	OnHold      *bool   `yaml:"on_hold,omitempty"`
+}
+
+// parseTableLayout reads and validates a layout file
+func parseTableLayout(data []byte) (*tableLayout, error) {
+	var layout tableLayout
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&layout); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid layout: %w", err)
+	}
+	if len(layout.Columns) == 0 {
+		return nil, fmt.Errorf("invalid layout: no columns")
+	}
+
+	seen := make(map[string]bool)
+	for i := range layout.Columns {
+		column := &layout.Columns[i]
+		column.Title = strings.TrimSpace(column.Title)
+		if column.Title == "" {
+			return nil, fmt.Errorf("invalid layout: column %d has no title", i+1)
+		}
+		for _, name := range []string{column.Title, column.RenamedFrom} {
+			key := strings.ToLower(strings.TrimSpace(name))
+			if key == "" {
+				continue
+			}
+			if seen[key] {
+				return nil, fmt.Errorf("invalid layout: column %q appears more than once", name)
+			}
+			seen[key] = true
+		}
+		if column.Color != "" {
+			color, err := utils.ValidateColor(column.Color)
+			if err != nil {
+				return nil, fmt.Errorf("invalid layout: column %q: %w", column.Title, err)
+			}
+			column.Color = color
+		}
+	}
+
+	return &layout, nil
+}
+
+type layoutAction int
+
+const (
+	layoutCreate layoutAction = iota
+	layoutUpdate
+	layoutColor
+	layoutHold
+	layoutUnhold
+	layoutMove
+)
+
+// layoutChange is one step towards a layout. columnID is 0 for columns
+// created earlier in the same plan.
+type layoutChange struct {
+	action   layoutAction
+	columnID int64
+	title    string
+
+	// layoutCreate and layoutUpdate
+	oldTitle       string
+	description    *string
+	oldDescription string
+
+	// layoutCreate and layoutColor
+	color    string
+	oldColor string
+
+	// layoutMove
+	targetID int64
+	target   string
+	position string
+}
+
+func (c layoutChange) String() string {
+	switch c.action {
+	case layoutCreate:
+		var details []string
+		if c.color != "" {
+			details = append(details, "color "+c.color)
+		}
+		if c.description != nil && *c.description != "" {
+			details = append(details, fmt.Sprintf("description %q", *c.description))
+		}
+		if len(details) == 0 {
+			return fmt.Sprintf("+ create column %q", c.title)
+		}
+		return fmt.Sprintf("+ create column %q (%s)", c.title, strings.Join(details, ", "))
+	case layoutUpdate:
+		var parts []string
+		if c.oldTitle != "" && c.oldTitle != c.title {
+			parts = append(parts, fmt.Sprintf("rename column %q to %q", c.oldTitle, c.title))
+		}
+		if c.description != nil {
+			parts = append(parts, fmt.Sprintf("change description of %q from %q to %q", c.title, c.oldDescription, *c.description))
+		}
+		return "~ " + strings.Join(parts, "; ")
+	case layoutColor:
+		old := c.oldColor
+		if old == "" {
+			old = "none"
+		}
+		return fmt.Sprintf("~ change color of %q from %s to %s", c.title, old, c.color)
+	case layoutHold:
+		return fmt.Sprintf("~ enable on-hold for %q", c.title)
+	case layoutUnhold:
+		return fmt.Sprintf("~ disable on-hold for %q", c.title)
+	case layoutMove:
+		return fmt.Sprintf("↕ move %q %s %q", c.title, c.position, c.target)
+	}
+	return ""
+}
+
+// layoutPlan is what it takes to bring a card table in line with a layout
+type layoutPlan struct {
+	changes []layoutChange
+	// unmanaged are columns of the card table the layout doesn't mention;
+	// they are left alone
+	unmanaged []api.Column
+}
+
+// planLayout compares a layout with the columns of a card table. Columns
+// are matched by title, then by renamed_from. Triage, Done and Not Now
+// columns can be updated but keep their place; columns created by the plan
+// are assumed to be added after the existing ones.
+func planLayout(layout *tableLayout, columns []api.Column) *layoutPlan {
+	plan := &layoutPlan{}
+	matched := make(map[int64]bool)
+
+	find := func(title string) *api.Column {
+		if title == "" {
+			return nil
+		}
+		for i := range columns {
+			if !matched[columns[i].ID] && strings.EqualFold(strings.TrimSpace(columns[i].Title), strings.TrimSpace(title)) {
+				return &columns[i]
+			}
+		}
+		return nil
+	}
+
+	// Match every title first so a rename can't claim a column another
+	// entry refers to by its current title
+	live := make([]*api.Column, len(layout.Columns))
+	for i, want := range layout.Columns {
+		if column := find(want.Title); column != nil {
+			live[i] = column
+			matched[column.ID] = true
+		}
+	}
+	for i, want := range layout.Columns {
+		if live[i] != nil {
+			continue
+		}
+		if column := find(want.RenamedFrom); column != nil {
+			live[i] = column
+			matched[column.ID] = true
+		}
+	}
+
+	// Work columns in their order after any creations, using placeholder
+	// IDs for new columns
+	var order []layoutOrderEntry
+	for _, column := range columns {
+		if isWorkColumnType(column.Type) {
+			order = append(order, layoutOrderEntry{id: column.ID, title: column.Title})
+		}
+	}
+	var desired []layoutOrderEntry
+
+	for i, want := range layout.Columns {
+		column := live[i]
+		if column == nil {
+			plan.changes = append(plan.changes, layoutChange{
+				action:      layoutCreate,
+				title:       want.Title,
+				description: want.Description,
+				color:       want.Color,
+			})
+			if want.OnHold != nil && *want.OnHold {
+				plan.changes = append(plan.changes, layoutChange{action: layoutHold, title: want.Title})
+			}
+			entry := layoutOrderEntry{id: -int64(i + 1), title: want.Title}
+			order = append(order, entry)
+			desired = append(desired, entry)
+			continue
+		}
+
+		if isWorkColumnType(column.Type) {
+			desired = append(desired, layoutOrderEntry{id: column.ID, title: want.Title})
+		}
+
+		update := layoutChange{action: layoutUpdate, columnID: column.ID, title: want.Title}
+		if column.Title != want.Title {
+			update.oldTitle = column.Title
+		}
+		if want.Description != nil && strings.TrimSpace(*want.Description) != strings.TrimSpace(column.Description) {
+			update.description = want.Description
+			update.oldDescription = column.Description
+		}
+		if update.oldTitle != "" || update.description != nil {
+			plan.changes = append(plan.changes, update)
+		}
+
+		if want.Color != "" && !strings.EqualFold(want.Color, column.Color) {
+			plan.changes = append(plan.changes, layoutChange{action: layoutColor, columnID: column.ID, title: want.Title, color: want.Color, oldColor: column.Color})
+		}
+
+		if want.OnHold != nil && *want.OnHold != column.OnHold.Enabled {
+			action := layoutUnhold
+			if *want.OnHold {
+				action = layoutHold
+			}
+			plan.changes = append(plan.changes, layoutChange{action: action, columnID: column.ID, title: want.Title})
+		}
+	}
+
+	for _, column := range columns {
+		if !matched[column.ID] {
+			plan.unmanaged = append(plan.unmanaged, column)
+		}
+	}
+
+	plan.changes = append(plan.changes, planColumnMoves(desired, order)...)
+	return plan
+}
+
+// layoutOrderEntry is a work column in the order of a card table
+type layoutOrderEntry struct {
+	id    int64
+	title string
+}
+
+// planColumnMoves works out the fewest moves that put the desired columns
+// in order. Columns that already form the longest run in the right order
+// stay put; every other column is moved right after the column preceding it
+// in the layout, or to the front. Columns in current that aren't desired
+// keep their place.
+func planColumnMoves(desired, current []layoutOrderEntry) []layoutChange {
+	if len(desired) < 2 {
+		return nil
+	}
+
+	position := make(map[int64]int, len(current))
+	for i, entry := range current {
+		position[entry.id] = i
+	}
+	keep := longestIncreasingRun(desired, position)
+
+	order := append([]layoutOrderEntry(nil), current...)
+	indexOf := func(id int64) int {
+		for i, entry := range order {
+			if entry.id == id {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var moves []layoutChange
+	for i, entry := range desired {
+		if keep[i] {
+			continue
+		}
+		from := indexOf(entry.id)
+		order = append(order[:from], order[from+1:]...)
+
+		move := layoutChange{action: layoutMove, columnID: entry.id, title: entry.title}
+		at := 0
+		if i == 0 {
+			move.targetID, move.target, move.position = order[0].id, order[0].title, "before"
+		} else {
+			prev := desired[i-1]
+			at = indexOf(prev.id) + 1
+			move.targetID, move.target, move.position = prev.id, prev.title, "after"
+		}
+		order = append(order[:at], append([]layoutOrderEntry{entry}, order[at:]...)...)
+		moves = append(moves, move)
+	}
+
+	return moves
+}
+
+// longestIncreasingRun marks the entries of desired forming the longest
+// subsequence whose current positions are increasing
+func longestIncreasingRun(desired []layoutOrderEntry, position map[int64]int) []bool {
+	n := len(desired)
+	length := make([]int, n)
+	prev := make([]int, n)
+	best := -1
+	for i := range desired {
+		length[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if position[desired[j].id] < position[desired[i].id] && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+		if best == -1 || length[i] > length[best] {
+			best = i
+		}
+	}
+
+	keep := make([]bool, n)
+	for i := best; i >= 0; i = prev[i] {
+		keep[i] = true
+	}
+	return keep
+}
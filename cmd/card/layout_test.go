@@ -0,0 +1,170 @@
+package card
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestParseTableLayout(t *testing.T) {
+	layout, err := parseTableLayout([]byte(`
+columns:
+  - title: Doing
+    color: Blue
+    description: ""
+    on_hold: true
+  - title: Review
+    renamed_from: QA
+`))
+	require.NoError(t, err)
+	require.Len(t, layout.Columns, 2)
+	assert.Equal(t, "blue", layout.Columns[0].Color)
+	require.NotNil(t, layout.Columns[0].Description)
+	assert.Equal(t, "", *layout.Columns[0].Description)
+	assert.True(t, *layout.Columns[0].OnHold)
+	assert.Nil(t, layout.Columns[1].OnHold)
+
+	tests := []struct {
+		name string
+		yaml string
+		err  string
+	}{
+		{name: "empty", yaml: "", err: "no columns"},
+		{name: "missing title", yaml: "columns:\n  - color: red\n", err: "column 1 has no title"},
+		{name: "duplicate", yaml: "columns:\n  - title: A\n  - title: B\n    renamed_from: a\n", err: "more than once"},
+		{name: "bad color", yaml: "columns:\n  - title: A\n    color: teal\n", err: "invalid color"},
+		{name: "unknown field", yaml: "columns:\n  - title: A\n    colour: red\n", err: "colour"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTableLayout([]byte(tt.yaml))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestPlanLayout(t *testing.T) {
+	yes, no := true, false
+	desc := "Ready to start"
+	columns := []api.Column{
+		{ID: 1, Title: "Triage", Type: columnTypeTriage},
+		{ID: 2, Title: "Doing", Type: "Kanban::Column", Color: "white", OnHold: api.OnHoldStatus{Enabled: true}},
+		{ID: 3, Title: "QA", Type: "Kanban::Column", Description: "Checks"},
+		{ID: 4, Title: "up next", Type: "Kanban::Column", Color: "blue"},
+		{ID: 5, Title: "Ideas", Type: "Kanban::Column"},
+		{ID: 6, Title: "Done", Type: columnTypeDone},
+	}
+	layout := &tableLayout{Columns: []layoutColumn{
+		{Title: "Triage", Color: "gray"},
+		{Title: "Up next", Color: "blue", Description: &desc},
+		{Title: "Doing", Color: "yellow", OnHold: &no},
+		{Title: "Review", RenamedFrom: "QA", OnHold: &yes},
+		{Title: "Blocked", Color: "red", OnHold: &yes},
+		{Title: "Done"},
+	}}
+
+	plan := planLayout(layout, columns)
+
+	var lines []string
+	for _, change := range plan.changes {
+		lines = append(lines, change.String())
+	}
+	assert.Equal(t, []string{
+		`~ change color of "Triage" from none to gray`,
+		`~ rename column "up next" to "Up next"; change description of "Up next" from "" to "Ready to start"`,
+		`~ change color of "Doing" from white to yellow`,
+		`~ disable on-hold for "Doing"`,
+		`~ rename column "QA" to "Review"`,
+		`~ enable on-hold for "Review"`,
+		`+ create column "Blocked" (color red)`,
+		`~ enable on-hold for "Blocked"`,
+		`↕ move "Up next" before "Doing"`,
+	}, lines)
+
+	require.Len(t, plan.unmanaged, 1)
+	assert.Equal(t, "Ideas", plan.unmanaged[0].Title)
+
+	move := plan.changes[len(plan.changes)-1]
+	assert.Equal(t, int64(4), move.columnID)
+	assert.Equal(t, int64(2), move.targetID)
+
+	// A table that already matches needs nothing
+	matching := &tableLayout{Columns: []layoutColumn{{Title: "Triage"}, {Title: "Doing"}, {Title: "QA"}}}
+	assert.Empty(t, planLayout(matching, columns).changes)
+}
+
+func TestPlanLayoutClearsDescription(t *testing.T) {
+	empty := ""
+	columns := []api.Column{{ID: 3, Title: "QA", Type: "Kanban::Column", Description: "Checks"}}
+	plan := planLayout(&tableLayout{Columns: []layoutColumn{{Title: "QA", Description: &empty}}}, columns)
+
+	require.Len(t, plan.changes, 1)
+	change := plan.changes[0]
+	assert.Equal(t, layoutUpdate, change.action)
+
+	body, err := json.Marshal(api.ColumnUpdateRequest{Title: change.title, Description: change.description})
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"description":""`)
+}
+
+func TestPlanColumnMoves(t *testing.T) {
+	entries := func(ids ...int64) []layoutOrderEntry {
+		out := make([]layoutOrderEntry, len(ids))
+		for i, id := range ids {
+			out[i] = layoutOrderEntry{id: id, title: string(rune('A' + id - 1))}
+		}
+		return out
+	}
+	apply := func(order []layoutOrderEntry, moves []layoutChange) []int64 {
+		ids := make([]int64, 0, len(order))
+		for _, entry := range order {
+			ids = append(ids, entry.id)
+		}
+		for _, move := range moves {
+			for i, id := range ids {
+				if id == move.columnID {
+					ids = append(ids[:i], ids[i+1:]...)
+					break
+				}
+			}
+			at := 0
+			for i, id := range ids {
+				if id == move.targetID {
+					at = i
+					if move.position == "after" {
+						at++
+					}
+					break
+				}
+			}
+			ids = append(ids[:at], append([]int64{move.columnID}, ids[at:]...)...)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name    string
+		desired []int64
+		current []int64
+		moves   int
+		want    []int64
+	}{
+		{name: "in order", desired: []int64{1, 2, 3}, current: []int64{1, 2, 3}, moves: 0, want: []int64{1, 2, 3}},
+		{name: "last to front", desired: []int64{3, 1, 2}, current: []int64{1, 2, 3}, moves: 1, want: []int64{3, 1, 2}},
+		{name: "first to back", desired: []int64{2, 3, 1}, current: []int64{1, 2, 3}, moves: 1, want: []int64{2, 3, 1}},
+		{name: "reversed", desired: []int64{4, 3, 2, 1}, current: []int64{1, 2, 3, 4}, moves: 3, want: []int64{4, 3, 2, 1}},
+		{name: "unmanaged stay", desired: []int64{3, 1}, current: []int64{1, 5, 3}, moves: 1, want: []int64{5, 3, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := entries(tt.current...)
+			moves := planColumnMoves(entries(tt.desired...), current)
+			assert.Len(t, moves, tt.moves)
+			assert.Equal(t, tt.want, apply(current, moves))
+		})
+	}
+}
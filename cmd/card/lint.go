@@ -0,0 +1,229 @@
+package card
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/tui"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// Lint checks
+const (
+	lintOverLimit  = "wip-limit"
+	lintUnassigned = "unassigned"
+	lintOverdue    = "overdue"
+	lintStale      = "stale"
+	lintStepsDone  = "steps-done"
+)
+
+type lintIssue struct {
+	Check  string `json:"check"`
+	Column string `json:"column"`
+	CardID int64  `json:"card_id,omitempty"`
+	Card   string `json:"card,omitempty"`
+	Detail string `json:"detail"`
+}
+
+func newLintCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var staleDays int
+	var formatStr string
+
+	cmd := &cobra.Command{
+		Use:   "lint [table]",
+		Short: "Check a card table against WIP limits and working agreements",
+		Long: `Check a card table for cards and columns that need attention:
+
+  wip-limit    columns holding more cards than their WIP limit (on-hold cards
+               don't count)
+  unassigned   cards nobody is assigned to in columns where work happens
+               (any column other than triage, "not now" and done)
+  overdue      cards past their due date that aren't done
+  stale        cards not updated for --stale-days days, outside "not now"
+               and done
+  steps-done   cards with every step completed that haven't moved to done
+
+WIP limits are set with 'bc4 card column limit' or a "WIP limits:" line in the
+card table description.
+
+The command exits with status 1 when it finds problems, so it can be used in
+scripts.`,
+		Example: `  # Lint the default card table
+  bc4 card lint
+
+  # Treat cards untouched for a week as stale
+  bc4 card lint "Product roadmap" --stale-days 7
+
+  # Machine-readable output
+  bc4 card lint --format json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := ui.ParseOutputFormat(formatStr)
+			if err != nil {
+				return err
+			}
+			if staleDays < 1 {
+				return fmt.Errorf("--stale-days must be at least 1")
+			}
+
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+			identifier := ""
+			if len(args) > 0 {
+				identifier = args[0]
+			}
+			f, err = withCardTableURL(f, identifier)
+			if err != nil {
+				return err
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+			loc, err := f.Location()
+			if err != nil {
+				return err
+			}
+
+			cardTable, err := resolveCardTable(f, client, resolvedProjectID, identifier)
+			if err != nil {
+				return err
+			}
+			table, columns, err := tui.LoadBoard(f.Context(), client.Client, resolvedProjectID, cardTable.ID)
+			if err != nil {
+				return err
+			}
+			limits, err := loadWIPLimits(f, table)
+			if err != nil {
+				return err
+			}
+
+			issues := lintBoard(columns, limits, time.Now().In(loc), staleDays)
+
+			switch format {
+			case ui.OutputFormatJSON:
+				if issues == nil {
+					issues = []lintIssue{}
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				if err := encoder.Encode(issues); err != nil {
+					return err
+				}
+			case ui.OutputFormatCSV:
+				return fmt.Errorf("csv output is not supported for lint")
+			default:
+				if len(issues) == 0 {
+					fmt.Printf("✓ No problems found on card table '%s'\n", table.Title)
+					return nil
+				}
+				tp := tableprinter.New(os.Stdout)
+				tp.AddHeader("CHECK", "COLUMN", "CARD", "DETAIL")
+				for _, issue := range issues {
+					tp.AddField(issue.Check)
+					tp.AddField(issue.Column)
+					if issue.CardID != 0 {
+						tp.AddField(fmt.Sprintf("#%d %s", issue.CardID, issue.Card))
+					} else {
+						tp.AddField("")
+					}
+					tp.AddField(issue.Detail)
+					tp.EndRow()
+				}
+				if err := tp.Render(); err != nil {
+					return err
+				}
+			}
+
+			if len(issues) > 0 {
+				return cmdutil.NewSilentError(fmt.Errorf("%d problems found on card table '%s'", len(issues), table.Title))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().IntVar(&staleDays, "stale-days", 14, "Days without an update before a card counts as stale")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+
+	return cmd
+}
+
+// lintBoard runs every check over the board, column by column
+func lintBoard(columns []tui.BoardColumn, limits map[int64]int, now time.Time, staleDays int) []lintIssue {
+	var issues []lintIssue
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	staleBefore := now.AddDate(0, 0, -staleDays)
+
+	for _, column := range columns {
+		title := column.Column.Title
+		kind := column.Column.Type
+
+		if limit, ok := limits[column.Column.ID]; ok {
+			active := wipCount(column.Cards)
+			if active > limit {
+				issues = append(issues, lintIssue{
+					Check:  lintOverLimit,
+					Column: title,
+					Detail: fmt.Sprintf("%d cards, limit is %d", active, limit),
+				})
+			}
+		}
+
+		if kind == columnTypeDone {
+			continue
+		}
+
+		for _, card := range column.Cards {
+			issue := func(check, detail string) {
+				issues = append(issues, lintIssue{Check: check, Column: title, CardID: card.ID, Card: card.Title, Detail: detail})
+			}
+
+			if isWorkColumnType(kind) && len(card.Assignees) == 0 {
+				issue(lintUnassigned, "nobody is assigned")
+			}
+			if card.DueOn != nil && *card.DueOn != "" {
+				if due, err := time.ParseInLocation("2006-01-02", *card.DueOn, now.Location()); err == nil && due.Before(today) {
+					issue(lintOverdue, "due "+*card.DueOn)
+				}
+			}
+			if kind != columnTypeNotNow && card.UpdatedAt.Before(staleBefore) {
+				issue(lintStale, "not updated for "+strconv.Itoa(int(now.Sub(card.UpdatedAt).Hours()/24))+" days")
+			}
+			if len(card.Steps) > 0 {
+				done := true
+				for _, step := range card.Steps {
+					if !step.Completed {
+						done = false
+						break
+					}
+				}
+				if done {
+					issue(lintStepsDone, fmt.Sprintf("all %d steps completed", len(card.Steps)))
+				}
+			}
+		}
+	}
+
+	return issues
+}
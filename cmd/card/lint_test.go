@@ -0,0 +1,105 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/tui"
+)
+
+func TestParseWIPLimits(t *testing.T) {
+	columns := []api.Column{
+		{ID: 1, Title: "Triage"},
+		{ID: 2, Title: "In progress"},
+		{ID: 3, Title: "Review"},
+	}
+
+	tests := []struct {
+		name        string
+		description string
+		expected    map[int64]int
+	}{
+		{
+			name:        "rich text",
+			description: "<div>Our board.<br>WIP limits: In progress=3, review = 2</div>",
+			expected:    map[int64]int{2: 3, 3: 2},
+		},
+		{
+			name:        "colon pairs and unknown columns",
+			description: "WIP: Review: 1; Shipping: 4",
+			expected:    map[int64]int{3: 1},
+		},
+		{
+			name:        "no limits",
+			description: "<div>Just a description</div>",
+			expected:    map[int64]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseWIPLimits(tt.description, columns))
+		})
+	}
+}
+
+func TestLintBoard(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	yesterday := "2025-03-09"
+	today := "2025-03-10"
+	fresh := now.Add(-time.Hour)
+	person := []api.Person{{ID: 1, Name: "Ada"}}
+
+	columns := []tui.BoardColumn{
+		{
+			Column: api.Column{ID: 1, Title: "Triage", Type: columnTypeTriage},
+			Cards: []api.Card{
+				{ID: 10, Title: "Idea", UpdatedAt: fresh},
+			},
+		},
+		{
+			Column: api.Column{ID: 2, Title: "Doing"},
+			Cards: []api.Card{
+				{ID: 20, Title: "Nobody", UpdatedAt: fresh},
+				{ID: 21, Title: "Late", Assignees: person, DueOn: &yesterday, UpdatedAt: fresh},
+				{ID: 22, Title: "Due today", Assignees: person, DueOn: &today, UpdatedAt: fresh},
+				{ID: 23, Title: "Forgotten", Assignees: person, UpdatedAt: now.AddDate(0, 0, -20)},
+				{ID: 24, Title: "Finished", Assignees: person, UpdatedAt: fresh, Steps: []api.Step{{Completed: true}, {Completed: true}}},
+				{ID: 25, Title: "Blocked", Assignees: person, UpdatedAt: fresh, IsOnHold: true},
+			},
+		},
+		{
+			Column: api.Column{ID: 3, Title: "Not now", Type: columnTypeNotNow},
+			Cards: []api.Card{
+				{ID: 30, Title: "Parked", UpdatedAt: now.AddDate(0, 0, -60)},
+			},
+		},
+		{
+			Column: api.Column{ID: 4, Title: "Done", Type: columnTypeDone},
+			Cards: []api.Card{
+				{ID: 40, Title: "Shipped", DueOn: &yesterday, UpdatedAt: now.AddDate(0, 0, -60)},
+			},
+		},
+	}
+
+	issues := lintBoard(columns, map[int64]int{2: 4}, now, 14)
+
+	assert.Equal(t, []lintIssue{
+		{Check: lintOverLimit, Column: "Doing", Detail: "5 cards, limit is 4"},
+		{Check: lintUnassigned, Column: "Doing", CardID: 20, Card: "Nobody", Detail: "nobody is assigned"},
+		{Check: lintOverdue, Column: "Doing", CardID: 21, Card: "Late", Detail: "due 2025-03-09"},
+		{Check: lintStale, Column: "Doing", CardID: 23, Card: "Forgotten", Detail: "not updated for 20 days"},
+		{Check: lintStepsDone, Column: "Doing", CardID: 24, Card: "Finished", Detail: "all 2 steps completed"},
+	}, issues)
+}
+
+func TestWIPCountSkipsOnHoldCards(t *testing.T) {
+	cards := []api.Card{{ID: 1}, {ID: 2, IsOnHold: true}, {ID: 3}}
+	assert.Equal(t, 2, wipCount(cards))
+
+	issues := lintBoard([]tui.BoardColumn{{Column: api.Column{ID: 5, Title: "Doing", Type: columnTypeDone}, Cards: cards}}, map[int64]int{5: 2}, time.Now(), 14)
+	assert.Empty(t, issues, "a column at its limit once on-hold cards are left out is fine")
+}
@@ -1,23 +1,147 @@
 package card
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/tui"
+	"github.com/needmore/bc4/internal/ui"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
+// cardListFilter holds the card list filter flags
+type cardListFilter struct {
+	assignee     string
+	column       string
+	dueBefore    string
+	overdue      bool
+	openSteps    bool
+	updatedSince string
+	format       string
+}
+
+func (o *cardListFilter) isSet() bool {
+	return o.assignee != "" || o.column != "" || o.dueBefore != "" || o.overdue || o.openSteps || o.updatedSince != ""
+}
+
+// cardFilter is a resolved card list filter
+type cardFilter struct {
+	assigneeID   int64
+	column       string
+	dueBefore    string // YYYY-MM-DD, exclusive
+	overdue      bool
+	today        string // YYYY-MM-DD
+	openSteps    bool
+	updatedSince time.Time
+}
+
+// matches reports whether a card in the given column passes the filter.
+// Cards in Done columns are never overdue.
+func (cf *cardFilter) matches(column api.Column, card api.Card) bool {
+	if cf.assigneeID != 0 && !containsPerson(card.Assignees, cf.assigneeID) {
+		return false
+	}
+	if cf.column != "" && !strings.Contains(strings.ToLower(column.Title), strings.ToLower(cf.column)) {
+		return false
+	}
+	due := ""
+	if card.DueOn != nil {
+		due = *card.DueOn
+	}
+	if cf.dueBefore != "" && (due == "" || due >= cf.dueBefore) {
+		return false
+	}
+	if cf.overdue && (due == "" || due >= cf.today || column.Type == columnTypeDone) {
+		return false
+	}
+	if cf.openSteps {
+		open := false
+		for _, step := range card.Steps {
+			if !step.Completed {
+				open = true
+				break
+			}
+		}
+		if !open {
+			return false
+		}
+	}
+	if !cf.updatedSince.IsZero() && card.UpdatedAt.Before(cf.updatedSince) {
+		return false
+	}
+	return true
+}
+
+func containsPerson(people []api.Person, id int64) bool {
+	for _, person := range people {
+		if person.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// listedCard is a card found by the card list filters, with where it lives
+type listedCard struct {
+	api.Card
+	CardTable string `json:"card_table"`
+	Column    string `json:"column"`
+	OnHold    bool   `json:"on_hold"`
+}
+
+// filterCards applies a filter to the cards of several card tables, in
+// table and column order
+func filterCards(tables []*api.CardTable, boards [][]tui.BoardColumn, filter *cardFilter) []listedCard {
+	var matches []listedCard
+	for i, table := range tables {
+		for _, column := range boards[i] {
+			for _, card := range column.Cards {
+				if filter.matches(column.Column, card) {
+					matches = append(matches, listedCard{Card: card, CardTable: table.Title, Column: column.Column.Title, OnHold: card.IsOnHold})
+				}
+			}
+		}
+	}
+	return matches
+}
+
 func newListCmd(f *factory.Factory) *cobra.Command {
 	var formatJSON bool
 	var accountID string
 	var projectID string
+	filterOpts := &cardListFilter{}
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List card tables in the current project",
-		Long:  `List all card tables in the current project with their card counts and status.`,
+		Long: `List all card tables in the current project with their card counts and status.
+
+With any of the filter flags, list the matching cards instead. Filters look
+at every column, including on-hold cards, of every card table in the
+project, and can be combined.`,
+		Example: `  # List the card tables in the project
+  bc4 card list
+
+  # Cards assigned to you that are overdue
+  bc4 card list --assignee me --overdue
+
+  # Cards in Review that still have open steps
+  bc4 card list --column review --has-open-steps
+
+  # Cards due before Friday, updated this week, as JSON
+  bc4 card list --due-before fri --updated-since 7d --format json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply overrides if specified
 			if accountID != "" {
@@ -27,6 +151,13 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 				f = f.WithProject(projectID)
 			}
 
+			if filterOpts.isSet() {
+				if formatJSON {
+					filterOpts.format = string(ui.OutputFormatJSON)
+				}
+				return runCardListFilter(f, filterOpts)
+			}
+
 			// Get API client from factory
 			client, err := f.ApiClient()
 			if err != nil {
@@ -120,6 +251,193 @@ func newListCmd(f *factory.Factory) *cobra.Command {
 	cmd.Flags().BoolVar(&formatJSON, "json", false, "Output in JSON format")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVar(&filterOpts.assignee, "assignee", "", "List cards assigned to this person (name, email, or \"me\")")
+	cmd.Flags().StringVar(&filterOpts.column, "column", "", "List cards in columns whose name contains this text")
+	cmd.Flags().StringVar(&filterOpts.dueBefore, "due-before", "", "List cards due before this date (YYYY-MM-DD, or e.g. fri, +7d)")
+	cmd.Flags().BoolVar(&filterOpts.overdue, "overdue", false, "List cards past their due date, outside Done columns")
+	cmd.Flags().BoolVar(&filterOpts.openSteps, "has-open-steps", false, "List cards with steps still to do")
+	cmd.Flags().StringVar(&filterOpts.updatedSince, "updated-since", "", "List cards updated since (e.g. 24h, 7d, 2w, or YYYY-MM-DD)")
+	cmd.Flags().StringVarP(&filterOpts.format, "format", "f", "table", "Output format for filtered cards: table, json, or csv")
 
 	return cmd
 }
+
+// runCardListFilter lists the cards in the project's card tables that match
+// the filter flags
+func runCardListFilter(f *factory.Factory, opts *cardListFilter) error {
+	format, err := ui.ParseOutputFormat(opts.format)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+	ctx := f.Context()
+	now := time.Now().In(loc)
+
+	filter := &cardFilter{
+		column:    opts.column,
+		overdue:   opts.overdue,
+		today:     now.Format("2006-01-02"),
+		openSteps: opts.openSteps,
+	}
+	if opts.assignee != "" {
+		if strings.EqualFold(opts.assignee, "me") {
+			me, err := client.People().GetMyProfile(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to get your profile: %w", err)
+			}
+			filter.assigneeID = me.ID
+		} else {
+			ids, err := utils.NewUserResolver(client.Client, projectID).ResolveUsers(ctx, []string{opts.assignee})
+			if err != nil {
+				return err
+			}
+			filter.assigneeID = ids[0]
+		}
+	}
+	if opts.dueBefore != "" {
+		filter.dueBefore, err = cmdutil.ResolveDate("Due before", opts.dueBefore, loc)
+		if err != nil {
+			return err
+		}
+	}
+	if opts.updatedSince != "" {
+		filter.updatedSince, err = cmdutil.ParseSince(opts.updatedSince, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	tables, err := client.Cards().GetAllProjectCardTables(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch card tables: %w", err)
+	}
+
+	boards := make([][]tui.BoardColumn, len(tables))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(fetchConcurrency)
+	for i, table := range tables {
+		g.Go(func() error {
+			api.GetRateLimiter().Wait()
+			_, columns, err := tui.LoadBoard(gctx, client.Client, projectID, table.ID)
+			if err != nil {
+				return err
+			}
+			boards[i] = columns
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	cards := filterCards(tables, boards, filter)
+
+	switch format {
+	case ui.OutputFormatJSON:
+		if cards == nil {
+			cards = []listedCard{}
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(cards)
+	case ui.OutputFormatCSV:
+		writer := csv.NewWriter(os.Stdout)
+		_ = writer.Write([]string{"id", "title", "card_table", "column", "on_hold", "assignees", "steps", "due_on", "updated_at"})
+		for _, card := range cards {
+			_ = writer.Write([]string{
+				strconv.FormatInt(card.ID, 10),
+				card.Title,
+				card.CardTable,
+				card.Column,
+				strconv.FormatBool(card.OnHold),
+				strings.Join(personNames(card.Assignees), ", "),
+				stepProgress(card.Steps),
+				dueOrEmpty(card.DueOn),
+				card.UpdatedAt.Format(time.RFC3339),
+			})
+		}
+		writer.Flush()
+		return writer.Error()
+	}
+
+	if len(cards) == 0 {
+		fmt.Println("No cards match the filters")
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+	if table.IsTTY() {
+		table.AddHeader("ID", "TITLE", "TABLE", "COLUMN", "ASSIGNEES", "STEPS", "DUE", "UPDATED")
+	} else {
+		table.AddHeader("ID", "TITLE", "TABLE", "COLUMN", "ASSIGNEES", "STEPS", "DUE", "STATUS", "UPDATED")
+	}
+	for _, card := range cards {
+		table.AddIDField(strconv.FormatInt(card.ID, 10), card.Status)
+		title := card.Title
+		if card.OnHold {
+			title = "[ON HOLD] " + title
+		}
+		table.AddProjectField(title, card.Status)
+		table.AddField(card.CardTable)
+		table.AddField(card.Column)
+		table.AddField(strings.Join(personNames(card.Assignees), ", "))
+		steps := stepProgress(card.Steps)
+		if steps == "" {
+			steps = "-"
+		}
+		table.AddField(steps)
+		due := dueOrEmpty(card.DueOn)
+		if due == "" {
+			due = "-"
+		}
+		table.AddField(due)
+		if !table.IsTTY() {
+			table.AddField(card.Status)
+		}
+		table.AddTimeField(card.CreatedAt, card.UpdatedAt)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func personNames(people []api.Person) []string {
+	names := make([]string, 0, len(people))
+	for _, person := range people {
+		names = append(names, person.Name)
+	}
+	return names
+}
+
+// stepProgress renders completed/total steps, or "" without steps
+func stepProgress(steps []api.Step) string {
+	if len(steps) == 0 {
+		return ""
+	}
+	completed := 0
+	for _, step := range steps {
+		if step.Completed {
+			completed++
+		}
+	}
+	return fmt.Sprintf("%d/%d", completed, len(steps))
+}
+
+func dueOrEmpty(due *string) string {
+	if due == nil {
+		return ""
+	}
+	return *due
+}
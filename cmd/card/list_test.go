@@ -0,0 +1,76 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/tui"
+)
+
+func TestCardFilter_Matches(t *testing.T) {
+	past := "2025-03-01"
+	future := "2025-03-20"
+	jane := api.Person{ID: 7, Name: "Jane"}
+	updated := time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC)
+
+	doing := api.Column{Title: "In Progress", Type: "Kanban::Column"}
+	done := api.Column{Title: "Done", Type: columnTypeDone}
+
+	card := api.Card{
+		DueOn:     &past,
+		Assignees: []api.Person{jane},
+		Steps:     []api.Step{{Completed: true}, {Completed: false}},
+		UpdatedAt: updated,
+	}
+
+	tests := []struct {
+		name   string
+		filter cardFilter
+		column api.Column
+		card   api.Card
+		want   bool
+	}{
+		{name: "no filter", column: doing, card: api.Card{}, want: true},
+		{name: "assignee", filter: cardFilter{assigneeID: 7}, column: doing, card: card, want: true},
+		{name: "other assignee", filter: cardFilter{assigneeID: 8}, column: doing, card: card, want: false},
+		{name: "column substring", filter: cardFilter{column: "progress"}, column: doing, card: card, want: true},
+		{name: "other column", filter: cardFilter{column: "review"}, column: doing, card: card, want: false},
+		{name: "due before", filter: cardFilter{dueBefore: "2025-03-10"}, column: doing, card: card, want: true},
+		{name: "due after", filter: cardFilter{dueBefore: "2025-03-01"}, column: doing, card: card, want: false},
+		{name: "due before without due date", filter: cardFilter{dueBefore: "2025-03-10"}, column: doing, card: api.Card{}, want: false},
+		{name: "overdue", filter: cardFilter{overdue: true, today: "2025-03-10"}, column: doing, card: card, want: true},
+		{name: "not yet due", filter: cardFilter{overdue: true, today: "2025-03-10"}, column: doing, card: api.Card{DueOn: &future}, want: false},
+		{name: "done is never overdue", filter: cardFilter{overdue: true, today: "2025-03-10"}, column: done, card: card, want: false},
+		{name: "open steps", filter: cardFilter{openSteps: true}, column: doing, card: card, want: true},
+		{name: "all steps done", filter: cardFilter{openSteps: true}, column: doing, card: api.Card{Steps: []api.Step{{Completed: true}}}, want: false},
+		{name: "no steps", filter: cardFilter{openSteps: true}, column: doing, card: api.Card{}, want: false},
+		{name: "updated since", filter: cardFilter{updatedSince: updated.Add(-time.Hour)}, column: doing, card: card, want: true},
+		{name: "not updated since", filter: cardFilter{updatedSince: updated.Add(time.Hour)}, column: doing, card: card, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.matches(tt.column, tt.card))
+		})
+	}
+}
+
+func TestFilterCards(t *testing.T) {
+	tables := []*api.CardTable{{Title: "Roadmap"}, {Title: "Support"}}
+	boards := [][]tui.BoardColumn{
+		{{Column: api.Column{Title: "Doing"}, Cards: []api.Card{{ID: 1, Title: "A"}, {ID: 2, Title: "B", IsOnHold: true}}}},
+		{{Column: api.Column{Title: "Inbox"}, Cards: []api.Card{{ID: 3, Title: "C"}}}},
+	}
+
+	cards := filterCards(tables, boards, &cardFilter{})
+	assert.Len(t, cards, 3)
+	assert.Equal(t, "Roadmap", cards[1].CardTable)
+	assert.Equal(t, "Doing", cards[1].Column)
+	assert.True(t, cards[1].OnHold, "on-hold cards are included")
+	assert.Equal(t, "Support", cards[2].CardTable)
+
+	assert.Empty(t, filterCards(tables, boards, &cardFilter{column: "review"}))
+}
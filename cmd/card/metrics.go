@@ -0,0 +1,679 @@
+package card
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/tui"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// columnMove is a card changing columns, as recorded in its events. from is
+// zero when the event doesn't say where the card came from.
+type columnMove struct {
+	at   time.Time
+	from int64
+	to   int64
+}
+
+// cardSegment is a stretch of time a card spent in one column. end is zero
+// while the card is still there.
+type cardSegment struct {
+	column int64
+	start  time.Time
+	end    time.Time
+}
+
+// cardHistory is a card's path across the board
+type cardHistory struct {
+	card     api.Card
+	segments []cardSegment
+}
+
+type durationStats struct {
+	Cards    int     `json:"cards"`
+	P50Days  float64 `json:"p50_days"`
+	P85Days  float64 `json:"p85_days"`
+	P95Days  float64 `json:"p95_days"`
+	MeanDays float64 `json:"mean_days"`
+}
+
+type columnMetrics struct {
+	Column     string  `json:"column"`
+	Cards      int     `json:"cards"`
+	MeanDays   float64 `json:"mean_days"`
+	MedianDays float64 `json:"median_days"`
+	WIP        int     `json:"wip"`
+	AvgWIP     float64 `json:"avg_wip"`
+}
+
+type weekThroughput struct {
+	Week      string `json:"week"`
+	Completed int    `json:"completed"`
+}
+
+type flowDay struct {
+	Date   string `json:"date"`
+	Counts []int  `json:"counts"`
+}
+
+type cardFlow struct {
+	ID        int64    `json:"id"`
+	Title     string   `json:"title"`
+	Column    string   `json:"column"`
+	Completed string   `json:"completed,omitempty"`
+	LeadDays  *float64 `json:"lead_days,omitempty"`
+	CycleDays *float64 `json:"cycle_days,omitempty"`
+}
+
+type cardMetrics struct {
+	Table      string           `json:"table"`
+	From       string           `json:"from"`
+	To         string           `json:"to"`
+	LeadTime   durationStats    `json:"lead_time"`
+	CycleTime  durationStats    `json:"cycle_time"`
+	Columns    []columnMetrics  `json:"columns"`
+	Throughput []weekThroughput `json:"throughput"`
+	FlowOrder  []string         `json:"flow_columns"`
+	Flow       []flowDay        `json:"flow"`
+	Cards      []cardFlow       `json:"cards"`
+}
+
+func newMetricsCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var since string
+	var formatStr string
+	var by string
+
+	cmd := &cobra.Command{
+		Use:   "metrics [table]",
+		Short: "Report cycle time, lead time, throughput and cumulative flow",
+		Long: `Report flow metrics for a card table, reconstructed from each card's
+column moves.
+
+  - Lead time runs from a card's creation until it reaches a done column
+  - Cycle time runs from when work started (the card first entered a column
+    other than triage or "not now") until it reached done
+  - Time in column, current WIP and average WIP for each column
+  - Throughput: cards reaching done per week
+  - A cumulative flow diagram of cards per column per day
+
+Only cards currently on the board are counted; archived and trashed cards
+have no history to read.
+
+Use --format json for everything, or --format csv with --by to pick which
+data set to export: flow (the default), throughput, columns or cards.`,
+		Example: `  # Metrics for the default card table over the last 30 days
+  bc4 card metrics
+
+  # A quarter of data for a named table
+  bc4 card metrics "Product roadmap" --since 90d
+
+  # Export for a retro spreadsheet
+  bc4 card metrics --since 2w --format csv --by cards > cards.csv
+  bc4 card metrics --format json > metrics.json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := ui.ParseOutputFormat(formatStr)
+			if err != nil {
+				return err
+			}
+			switch by {
+			case "flow", "throughput", "columns", "cards":
+			default:
+				return fmt.Errorf("invalid --by value %q: must be flow, throughput, columns or cards", by)
+			}
+
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+			identifier := ""
+			if len(args) > 0 {
+				identifier = args[0]
+			}
+			f, err = withCardTableURL(f, identifier)
+			if err != nil {
+				return err
+			}
+
+			loc, err := f.Location()
+			if err != nil {
+				return err
+			}
+			now := time.Now().In(loc)
+			from, err := cmdutil.ParseSince(since, now)
+			if err != nil {
+				return fmt.Errorf("invalid --since: %w", err)
+			}
+
+			client, err := f.ApiClient()
+			if err != nil {
+				return err
+			}
+			resolvedProjectID, err := f.ProjectID()
+			if err != nil {
+				return err
+			}
+			cardTable, err := resolveCardTable(f, client, resolvedProjectID, identifier)
+			if err != nil {
+				return err
+			}
+
+			table, columns, err := tui.LoadBoard(f.Context(), client.Client, resolvedProjectID, cardTable.ID)
+			if err != nil {
+				return err
+			}
+
+			histories, dropped, err := fetchCardHistories(f, client, resolvedProjectID, table.Lists, columns)
+			if err != nil {
+				return err
+			}
+			if dropped > 0 {
+				fmt.Fprintf(os.Stderr, "Note: dropped %d column moves that couldn't be matched to a column on this board\n", dropped)
+			}
+
+			metrics := computeCardMetrics(table.Lists, histories, from, now)
+			metrics.Table = table.Title
+
+			switch format {
+			case ui.OutputFormatJSON:
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(metrics)
+			case ui.OutputFormatCSV:
+				return writeMetricsCSV(metrics, by)
+			}
+			return renderMetrics(metrics, table.Lists)
+		},
+	}
+
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVar(&since, "since", "30d", "Start of the reporting period (e.g. 30d, 8w, 2025-01-01)")
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().StringVar(&by, "by", "flow", "Data set for CSV output: flow, throughput, columns, or cards")
+
+	return cmd
+}
+
+// fetchCardHistories reads the events of every card and rebuilds its path
+// across the board
+func fetchCardHistories(f *factory.Factory, client *api.ModularClient, projectID string, lists []api.Column, columns []tui.BoardColumn) ([]cardHistory, int, error) {
+	type cardInColumn struct {
+		card   api.Card
+		column int64
+	}
+	var cards []cardInColumn
+	for _, column := range columns {
+		for _, card := range column.Cards {
+			cards = append(cards, cardInColumn{card: card, column: column.Column.ID})
+		}
+	}
+
+	histories := make([]cardHistory, len(cards))
+	dropped := make([]int, len(cards))
+	limiter := api.GetRateLimiter()
+	g, ctx := errgroup.WithContext(f.Context())
+	g.SetLimit(fetchConcurrency)
+	for i, c := range cards {
+		g.Go(func() error {
+			limiter.Wait()
+			events, err := client.Activity().ListEvents(ctx, projectID, c.card.ID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch history of card #%d: %w", c.card.ID, err)
+			}
+			moves, skipped := parseColumnMoves(events, lists)
+			histories[i] = buildCardHistory(c.card, c.column, moves, lists)
+			dropped[i] = skipped
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, 0, err
+	}
+
+	total := 0
+	for _, n := range dropped {
+		total += n
+	}
+	return histories, total, nil
+}
+
+// columnChangedAction is the event Basecamp records when a card moves
+// between columns. Its details hold the columns it moved between as
+// "old_column" and "new_column" objects with an "id".
+const columnChangedAction = "column_changed"
+
+// parseColumnMoves picks the column changes out of a card's events. Moves
+// whose destination isn't a column on this board are dropped and counted.
+func parseColumnMoves(events []api.Event, lists []api.Column) ([]columnMove, int) {
+	var moves []columnMove
+	dropped := 0
+
+	for _, event := range events {
+		if event.Action != columnChangedAction {
+			continue
+		}
+		to := boardColumn(lists, detailColumnID(event.Details, "new_column"))
+		if to == 0 {
+			dropped++
+			continue
+		}
+		from := boardColumn(lists, detailColumnID(event.Details, "old_column"))
+		moves = append(moves, columnMove{at: event.CreatedAt, from: from, to: to})
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool { return moves[i].at.Before(moves[j].at) })
+	return moves, dropped
+}
+
+// detailColumnID reads the ID of a column object in event details
+func detailColumnID(details map[string]any, key string) int64 {
+	column, ok := details[key].(map[string]any)
+	if !ok {
+		return 0
+	}
+	id, _ := column["id"].(float64)
+	return int64(id)
+}
+
+// boardColumn returns the column with id, counting a column's on-hold
+// section as the column itself, or 0 when it isn't on the board
+func boardColumn(lists []api.Column, id int64) int64 {
+	if id == 0 {
+		return 0
+	}
+	for _, column := range lists {
+		if column.ID == id || column.OnHold.ID == id {
+			return column.ID
+		}
+	}
+	return 0
+}
+
+// buildCardHistory lays a card's moves out as segments, starting at its
+// creation and ending in the column it's in now. Without a known starting
+// column, a card that has moved is assumed to have started in the first
+// column of the table.
+func buildCardHistory(card api.Card, current int64, moves []columnMove, lists []api.Column) cardHistory {
+	initial := current
+	if len(moves) > 0 {
+		initial = moves[0].from
+		if initial == 0 && len(lists) > 0 {
+			initial = lists[0].ID
+		}
+	}
+
+	h := cardHistory{card: card}
+	h.segments = append(h.segments, cardSegment{column: initial, start: card.CreatedAt})
+	for _, move := range moves {
+		last := &h.segments[len(h.segments)-1]
+		if move.to == last.column || move.at.Before(last.start) {
+			continue
+		}
+		last.end = move.at
+		h.segments = append(h.segments, cardSegment{column: move.to, start: move.at})
+	}
+
+	// Moves we couldn't read may leave the card somewhere else; trust where
+	// it is now
+	if last := &h.segments[len(h.segments)-1]; last.column != current {
+		at := card.UpdatedAt
+		if at.Before(last.start) {
+			at = last.start
+		}
+		last.end = at
+		h.segments = append(h.segments, cardSegment{column: current, start: at})
+	}
+
+	return h
+}
+
+// columnAt returns the column the card was in at t, or zero if it didn't
+// exist yet
+func (h cardHistory) columnAt(t time.Time) int64 {
+	for _, s := range h.segments {
+		if !t.Before(s.start) && (s.end.IsZero() || t.Before(s.end)) {
+			return s.column
+		}
+	}
+	return 0
+}
+
+// computeCardMetrics derives the report for the period from..now
+func computeCardMetrics(lists []api.Column, histories []cardHistory, from, now time.Time) *cardMetrics {
+	kinds := make(map[int64]string, len(lists))
+	titles := make(map[int64]string, len(lists))
+	for _, column := range lists {
+		kinds[column.ID] = column.Type
+		titles[column.ID] = column.Title
+	}
+	isDone := func(id int64) bool { return kinds[id] == columnTypeDone }
+	isWork := func(id int64) bool { return isWorkColumnType(kinds[id]) }
+
+	m := &cardMetrics{
+		From: from.Format("2006-01-02"),
+		To:   now.Format("2006-01-02"),
+	}
+
+	// Lead and cycle time of cards finished in the period, and throughput
+	var leads, cycles []float64
+	weekly := make(map[string]int)
+	for _, h := range histories {
+		last := h.segments[len(h.segments)-1]
+		flow := cardFlow{ID: h.card.ID, Title: h.card.Title, Column: titles[last.column]}
+
+		if isDone(last.column) && !last.start.Before(from) && !last.start.After(now) {
+			flow.Completed = last.start.In(now.Location()).Format("2006-01-02")
+			lead := days(last.start.Sub(h.card.CreatedAt))
+			flow.LeadDays = &lead
+			leads = append(leads, lead)
+			for _, s := range h.segments {
+				if isWork(s.column) {
+					cycle := days(last.start.Sub(s.start))
+					flow.CycleDays = &cycle
+					cycles = append(cycles, cycle)
+					break
+				}
+			}
+		}
+		for i, s := range h.segments {
+			if i > 0 && isDone(s.column) && !isDone(h.segments[i-1].column) && !s.start.Before(from) && !s.start.After(now) {
+				weekly[weekStart(s.start.In(now.Location())).Format("2006-01-02")]++
+			}
+		}
+		m.Cards = append(m.Cards, flow)
+	}
+	m.LeadTime = summarize(leads)
+	m.CycleTime = summarize(cycles)
+
+	for week := weekStart(from.In(now.Location())); !week.After(now); week = week.AddDate(0, 0, 7) {
+		key := week.Format("2006-01-02")
+		m.Throughput = append(m.Throughput, weekThroughput{Week: key, Completed: weekly[key]})
+	}
+
+	// Cumulative flow: cards per column at the end of each day
+	loc := now.Location()
+	startDay := time.Date(from.In(loc).Year(), from.In(loc).Month(), from.In(loc).Day(), 0, 0, 0, 0, loc)
+	for _, column := range lists {
+		m.FlowOrder = append(m.FlowOrder, column.Title)
+	}
+	wipTotals := make([]int, len(lists))
+	for day := startDay; !day.After(now); day = day.AddDate(0, 0, 1) {
+		at := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		if at.After(now) {
+			at = now
+		}
+		counts := make([]int, len(lists))
+		for _, h := range histories {
+			column := h.columnAt(at)
+			for i, c := range lists {
+				if c.ID == column {
+					counts[i]++
+				}
+			}
+		}
+		for i, n := range counts {
+			wipTotals[i] += n
+		}
+		m.Flow = append(m.Flow, flowDay{Date: day.Format("2006-01-02"), Counts: counts})
+	}
+
+	// Time spent in each column within the period
+	for i, column := range lists {
+		var spent []float64
+		wip := 0
+		for _, h := range histories {
+			total := time.Duration(0)
+			visited := false
+			for _, s := range h.segments {
+				if s.column != column.ID {
+					continue
+				}
+				start, end := s.start, s.end
+				if end.IsZero() {
+					end = now
+				}
+				if start.Before(from) {
+					start = from
+				}
+				if end.After(start) {
+					visited = true
+					total += end.Sub(start)
+				}
+			}
+			if visited {
+				spent = append(spent, days(total))
+			}
+			if h.segments[len(h.segments)-1].column == column.ID {
+				wip++
+			}
+		}
+
+		stats := summarize(spent)
+		avgWIP := 0.0
+		if len(m.Flow) > 0 {
+			avgWIP = round1(float64(wipTotals[i]) / float64(len(m.Flow)))
+		}
+		m.Columns = append(m.Columns, columnMetrics{
+			Column:     column.Title,
+			Cards:      stats.Cards,
+			MeanDays:   stats.MeanDays,
+			MedianDays: stats.P50Days,
+			WIP:        wip,
+			AvgWIP:     avgWIP,
+		})
+	}
+
+	return m
+}
+
+// summarize returns nearest-rank percentiles and the mean, in days
+func summarize(values []float64) durationStats {
+	if len(values) == 0 {
+		return durationStats{}
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	percentile := func(p float64) float64 {
+		rank := int(math.Ceil(p/100*float64(len(sorted)))) - 1
+		return sorted[max(0, min(rank, len(sorted)-1))]
+	}
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	return durationStats{
+		Cards:    len(sorted),
+		P50Days:  round1(percentile(50)),
+		P85Days:  round1(percentile(85)),
+		P95Days:  round1(percentile(95)),
+		MeanDays: round1(sum / float64(len(sorted))),
+	}
+}
+
+func days(d time.Duration) float64 {
+	return round1(d.Hours() / 24)
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
+
+// weekStart returns midnight on the Monday of t's week
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+func formatDays(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64) + "d"
+}
+
+func renderMetrics(m *cardMetrics, lists []api.Column) error {
+	bold := lipgloss.NewStyle().Bold(true)
+	fmt.Printf("%s · %s – %s\n\n", bold.Render(m.Table), m.From, m.To)
+
+	for _, row := range []struct {
+		label string
+		stats durationStats
+	}{{"Lead time", m.LeadTime}, {"Cycle time", m.CycleTime}} {
+		if row.stats.Cards == 0 {
+			fmt.Printf("  %-11s no cards finished in this period\n", row.label)
+			continue
+		}
+		fmt.Printf("  %-11s %3d cards   p50 %-7s p85 %-7s p95 %-7s mean %s\n", row.label, row.stats.Cards,
+			formatDays(row.stats.P50Days), formatDays(row.stats.P85Days), formatDays(row.stats.P95Days), formatDays(row.stats.MeanDays))
+	}
+	fmt.Println()
+
+	columns := tableprinter.New(os.Stdout)
+	columns.AddHeader("COLUMN", "CARDS", "MEAN", "MEDIAN", "WIP", "AVG WIP")
+	for _, c := range m.Columns {
+		columns.AddField(c.Column)
+		columns.AddField(strconv.Itoa(c.Cards))
+		if c.Cards > 0 {
+			columns.AddField(formatDays(c.MeanDays))
+			columns.AddField(formatDays(c.MedianDays))
+		} else {
+			columns.AddField("-")
+			columns.AddField("-")
+		}
+		columns.AddField(strconv.Itoa(c.WIP))
+		columns.AddField(strconv.FormatFloat(c.AvgWIP, 'f', 1, 64))
+		columns.EndRow()
+	}
+	if err := columns.Render(); err != nil {
+		return err
+	}
+
+	weeks := make([]int, len(m.Throughput))
+	for i, w := range m.Throughput {
+		weeks[i] = w.Completed
+	}
+	fmt.Println("\n" + bold.Render("Throughput per week") + "  " + ui.Sparkline(weeks))
+	for _, w := range m.Throughput {
+		fmt.Printf("  %s  %3d  %s\n", w.Week, w.Completed, strings.Repeat("█", w.Completed))
+	}
+
+	fmt.Println("\n" + bold.Render("Cumulative flow"))
+	renderFlow(m, lists)
+	return nil
+}
+
+// flowRows is the most days the cumulative flow diagram shows; longer
+// periods are sampled
+const flowRows = 30
+
+// renderFlow draws the cumulative flow diagram as one stacked bar per day,
+// done columns first so finished work builds up on the left
+func renderFlow(m *cardMetrics, lists []api.Column) {
+	if len(m.Flow) == 0 {
+		return
+	}
+
+	order := make([]int, 0, len(lists))
+	for i := len(lists) - 1; i >= 0; i-- {
+		order = append(order, i)
+	}
+	shades := []string{"█", "▓", "▒", "░"}
+	styles := make([]lipgloss.Style, len(lists))
+	var legend []string
+	for n, i := range order {
+		styles[i] = lipgloss.NewStyle().Foreground(tui.ColumnColor(lists[i].Color))
+		legend = append(legend, styles[i].Render(shades[n%len(shades)])+" "+lists[i].Title)
+	}
+	fmt.Println("  " + strings.Join(legend, "  "))
+
+	peak := 0
+	for _, day := range m.Flow {
+		total := 0
+		for _, n := range day.Counts {
+			total += n
+		}
+		peak = max(peak, total)
+	}
+	width := 60
+	step := (len(m.Flow) + flowRows - 1) / flowRows
+
+	for d := 0; d < len(m.Flow); d += step {
+		day := m.Flow[d]
+		var bar strings.Builder
+		total := 0
+		for n, i := range order {
+			count := day.Counts[i]
+			total += count
+			if peak == 0 || count == 0 {
+				continue
+			}
+			cells := max(1, int(math.Round(float64(count)*float64(width)/float64(peak))))
+			bar.WriteString(styles[i].Render(strings.Repeat(shades[n%len(shades)], cells)))
+		}
+		fmt.Printf("  %s %s %d\n", day.Date[5:], bar.String(), total)
+	}
+}
+
+func writeMetricsCSV(m *cardMetrics, by string) error {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	var rows [][]string
+	switch by {
+	case "throughput":
+		rows = append(rows, []string{"week", "completed"})
+		for _, w := range m.Throughput {
+			rows = append(rows, []string{w.Week, strconv.Itoa(w.Completed)})
+		}
+	case "columns":
+		rows = append(rows, []string{"column", "cards", "mean_days", "median_days", "wip", "avg_wip"})
+		for _, c := range m.Columns {
+			rows = append(rows, []string{c.Column, strconv.Itoa(c.Cards),
+				strconv.FormatFloat(c.MeanDays, 'f', 1, 64), strconv.FormatFloat(c.MedianDays, 'f', 1, 64),
+				strconv.Itoa(c.WIP), strconv.FormatFloat(c.AvgWIP, 'f', 1, 64)})
+		}
+	case "cards":
+		rows = append(rows, []string{"id", "title", "column", "completed", "lead_days", "cycle_days"})
+		optional := func(v *float64) string {
+			if v == nil {
+				return ""
+			}
+			return strconv.FormatFloat(*v, 'f', 1, 64)
+		}
+		for _, c := range m.Cards {
+			rows = append(rows, []string{strconv.FormatInt(c.ID, 10), c.Title, c.Column, c.Completed, optional(c.LeadDays), optional(c.CycleDays)})
+		}
+	default:
+		rows = append(rows, append([]string{"date"}, m.FlowOrder...))
+		for _, day := range m.Flow {
+			record := []string{day.Date}
+			for _, n := range day.Counts {
+				record = append(record, strconv.Itoa(n))
+			}
+			rows = append(rows, record)
+		}
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
@@ -0,0 +1,121 @@
+package card
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+var metricsColumns = []api.Column{
+	{ID: 1, Title: "Triage", Type: columnTypeTriage},
+	{ID: 2, Title: "Doing", OnHold: api.OnHoldStatus{ID: 20}},
+	{ID: 3, Title: "Done", Type: columnTypeDone},
+}
+
+func metricsDay(day int, hour int) time.Time {
+	return time.Date(2025, 3, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestParseColumnMoves(t *testing.T) {
+	column := func(id int64) map[string]any { return map[string]any{"id": float64(id)} }
+	events := []api.Event{
+		{Action: "column_changed", CreatedAt: metricsDay(5, 9), Details: map[string]any{"old_column": column(2), "new_column": column(3)}},
+		{Action: "created", CreatedAt: metricsDay(1, 9)},
+		{Action: "column_changed", CreatedAt: metricsDay(2, 9), Details: map[string]any{"new_column": column(2)}},
+		{Action: "column_changed", CreatedAt: metricsDay(3, 9), Details: map[string]any{"old_column": column(2), "new_column": column(20)}},
+		{Action: "column_changed", CreatedAt: metricsDay(4, 9), Details: map[string]any{"new_column": column(99)}},
+		{Action: "column_changed", CreatedAt: metricsDay(4, 10), Details: map[string]any{"new_column": "Done"}},
+		{Action: "column_changed", CreatedAt: metricsDay(4, 11), Recording: api.Recording{Parent: &api.Parent{ID: 3, Title: "Done"}}},
+	}
+
+	moves, dropped := parseColumnMoves(events, metricsColumns)
+	assert.Equal(t, 3, dropped, "moves off the board or without a documented new_column are dropped")
+	assert.Equal(t, []columnMove{
+		{at: metricsDay(2, 9), to: 2},
+		{at: metricsDay(3, 9), from: 2, to: 2},
+		{at: metricsDay(5, 9), from: 2, to: 3},
+	}, moves)
+}
+
+func TestBuildCardHistory(t *testing.T) {
+	card := api.Card{ID: 1, CreatedAt: metricsDay(1, 9), UpdatedAt: metricsDay(6, 9)}
+
+	t.Run("follows moves", func(t *testing.T) {
+		h := buildCardHistory(card, 3, []columnMove{
+			{at: metricsDay(2, 9), to: 2},
+			{at: metricsDay(5, 9), from: 2, to: 3},
+		}, metricsColumns)
+		require.Len(t, h.segments, 3)
+		assert.Equal(t, int64(1), h.segments[0].column, "starts in the first column")
+		assert.Equal(t, int64(2), h.columnAt(metricsDay(3, 0)))
+		assert.Equal(t, int64(3), h.columnAt(metricsDay(20, 0)))
+		assert.Equal(t, int64(0), h.columnAt(metricsDay(1, 0)), "before it was created")
+	})
+
+	t.Run("never moved", func(t *testing.T) {
+		h := buildCardHistory(card, 2, nil, metricsColumns)
+		require.Len(t, h.segments, 1)
+		assert.Equal(t, int64(2), h.segments[0].column)
+	})
+
+	t.Run("ends in the current column", func(t *testing.T) {
+		h := buildCardHistory(card, 3, []columnMove{{at: metricsDay(2, 9), from: 1, to: 2}}, metricsColumns)
+		require.Len(t, h.segments, 3)
+		assert.Equal(t, cardSegment{column: 3, start: metricsDay(6, 9)}, h.segments[2])
+	})
+}
+
+func TestComputeCardMetrics(t *testing.T) {
+	histories := []cardHistory{
+		{
+			card: api.Card{ID: 1, Title: "Shipped", CreatedAt: metricsDay(3, 0)},
+			segments: []cardSegment{
+				{column: 1, start: metricsDay(3, 0), end: metricsDay(4, 0)},
+				{column: 2, start: metricsDay(4, 0), end: metricsDay(6, 0)},
+				{column: 3, start: metricsDay(6, 0)},
+			},
+		},
+		{
+			card: api.Card{ID: 2, Title: "In progress", CreatedAt: metricsDay(3, 0)},
+			segments: []cardSegment{
+				{column: 2, start: metricsDay(3, 0)},
+			},
+		},
+	}
+
+	m := computeCardMetrics(metricsColumns, histories, metricsDay(3, 0), metricsDay(9, 12))
+
+	assert.Equal(t, durationStats{Cards: 1, P50Days: 3, P85Days: 3, P95Days: 3, MeanDays: 3}, m.LeadTime)
+	assert.Equal(t, durationStats{Cards: 1, P50Days: 2, P85Days: 2, P95Days: 2, MeanDays: 2}, m.CycleTime)
+
+	require.Len(t, m.Columns, 3)
+	assert.Equal(t, columnMetrics{Column: "Doing", Cards: 2, MeanDays: 4.3, MedianDays: 2, WIP: 1, AvgWIP: 1.3}, m.Columns[1])
+	assert.Equal(t, 1, m.Columns[2].WIP)
+
+	// 2025-03-03 is a Monday
+	assert.Equal(t, []weekThroughput{{Week: "2025-03-03", Completed: 1}}, m.Throughput)
+
+	require.Len(t, m.Flow, 7)
+	assert.Equal(t, flowDay{Date: "2025-03-03", Counts: []int{1, 1, 0}}, m.Flow[0])
+	assert.Equal(t, flowDay{Date: "2025-03-06", Counts: []int{0, 1, 1}}, m.Flow[3])
+	assert.Equal(t, []string{"Triage", "Doing", "Done"}, m.FlowOrder)
+
+	require.Len(t, m.Cards, 2)
+	assert.Equal(t, "2025-03-06", m.Cards[0].Completed)
+	assert.Nil(t, m.Cards[1].LeadDays)
+}
+
+func TestSummarize(t *testing.T) {
+	stats := summarize([]float64{5, 1, 4, 2, 3, 10, 6, 7, 8, 9})
+	assert.Equal(t, durationStats{Cards: 10, P50Days: 5, P85Days: 9, P95Days: 10, MeanDays: 5.5}, stats)
+	assert.Equal(t, durationStats{}, summarize(nil))
+}
+
+func TestWeekStart(t *testing.T) {
+	assert.Equal(t, metricsDay(3, 0), weekStart(metricsDay(9, 15)), "Sunday belongs to the week before")
+	assert.Equal(t, metricsDay(10, 0), weekStart(metricsDay(10, 8)))
+}
@@ -16,11 +16,16 @@ func newMoveCmd(f *factory.Factory) *cobra.Command {
 	var accountID string
 	var projectID string
 	var onHold bool
+	var force bool
+	var before, after string
+	placement := &cardPlacement{}
+	transfer := &transferOptions{}
 
 	cmd := &cobra.Command{
 		Use:   "move [ID or URL]",
-		Short: "Move card between columns",
-		Long: `Move a card to a different column in the card table.
+		Short: "Move card between columns, card tables or projects",
+		Long: `Move a card to a different column in the card table, or to another card
+table or project.
 
 You can specify the card using either:
 - A numeric ID (e.g., "12345")
@@ -29,11 +34,29 @@ You can specify the card using either:
 Use --on-hold to move a card to the on-hold section of its current column
 (or target column if --column is also specified).
 
+Use --position, --top, --bottom, --before or --after to place the card
+within its column (the target column if --column is given, otherwise its
+current one). Positions start at 1 for the top of the column.
+
+Moves that would take a column over its WIP limit (see 'bc4 card column
+limit') are refused unless --force is given.
+
+Basecamp can only move cards within a card table, so --to-table and
+--to-project recreate the card in the destination (see 'bc4 card copy' for
+what is carried over), link the two cards with comments and archive the
+original.
+
 Examples:
   bc4 card move 123 --column "In Progress"
   bc4 card move 123 --column 456
   bc4 card move 123 --on-hold
   bc4 card move 123 --column "Developing" --on-hold
+  bc4 card move 123 --column "In Progress" --force
+  bc4 card move 123 --top
+  bc4 card move 123 --column "Up next" --position 3
+  bc4 card move 123 --after 456
+  bc4 card move 123 --to-table "Sprint 12" --column "Triage"
+  bc4 card move 123 --to-project "Website Redesign" --with-comments
   bc4 card move https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345 --column "Done"`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -43,8 +66,32 @@ Examples:
 				return fmt.Errorf("invalid card ID or URL: %s", args[0])
 			}
 
-			if columnName == "" && !onHold {
-				return fmt.Errorf("--column flag is required (or use --on-hold)")
+			if before != "" {
+				if placement.before, _, err = parser.ParseArgument(before); err != nil {
+					return fmt.Errorf("invalid card ID or URL: %s", before)
+				}
+			}
+			if after != "" {
+				if placement.after, _, err = parser.ParseArgument(after); err != nil {
+					return fmt.Errorf("invalid card ID or URL: %s", after)
+				}
+			}
+			if cmd.Flags().Changed("position") && placement.position < 1 {
+				return fmt.Errorf("--position must be 1 or more")
+			}
+
+			relocating := transfer.toTable != "" || transfer.toProject != ""
+			if relocating && onHold {
+				return fmt.Errorf("--on-hold can't be combined with --to-table or --to-project")
+			}
+			if placement.set() && (relocating || onHold) {
+				return fmt.Errorf("--position, --top, --bottom, --before and --after can't be combined with --on-hold, --to-table or --to-project")
+			}
+			if transfer.withComments && !relocating {
+				return fmt.Errorf("--with-comments requires --to-table or --to-project")
+			}
+			if columnName == "" && !onHold && !relocating && !placement.set() {
+				return fmt.Errorf("--column flag is required (or use --on-hold, --position, --to-table or --to-project)")
 			}
 
 			// Apply overrides if specified
@@ -81,6 +128,11 @@ Examples:
 			}
 			cardOps := client.Cards()
 
+			if relocating {
+				transfer.column = columnName
+				return runCardTransfer(f, client, resolvedProjectID, cardID, transfer, true)
+			}
+
 			// First, get the card to find its current location
 			card, err := cardOps.GetCard(f.Context(), resolvedProjectID, cardID)
 			if err != nil {
@@ -134,35 +186,35 @@ Examples:
 				return nil
 			}
 
-			// Find the target column by name or ID within the same card table
-			var targetColumnID int64
+			// Find the target column by name or ID within the same card table,
+			// or the card's current column when only positioning
+			targetColumn, err := findColumn(currentCardTable, columnName, card)
+			if err != nil {
+				return err
+			}
+			targetColumnID := targetColumn.ID
 
-			// Try to parse as ID first
-			if id, err := strconv.ParseInt(columnName, 10, 64); err == nil {
-				// Validate that the column ID exists in the current card table
-				found := false
-				for _, column := range currentCardTable.Lists {
-					if column.ID == id {
-						targetColumnID = id
-						found = true
-						break
-					}
+			// Respect the target column's WIP limit
+			if card.Parent == nil || card.Parent.ID != targetColumnID {
+				if err := checkWIPLimit(f, cardOps, resolvedProjectID, currentCardTable, targetColumnID, force); err != nil {
+					return err
 				}
-				if !found {
-					return fmt.Errorf("column ID %d not found in card table '%s'", id, currentCardTable.Title)
+			}
+
+			if placement.set() {
+				cards, err := cardOps.GetCardsInColumn(f.Context(), resolvedProjectID, targetColumnID)
+				if err != nil {
+					return fmt.Errorf("failed to get cards in column: %w", err)
 				}
-			} else {
-				// Search by name in the same card table
-				columnNameLower := strings.ToLower(columnName)
-				for _, column := range currentCardTable.Lists {
-					if strings.ToLower(column.Title) == columnNameLower {
-						targetColumnID = column.ID
-						break
-					}
+				position, err := placement.resolve(cards, cardID)
+				if err != nil {
+					return err
 				}
-				if targetColumnID == 0 {
-					return fmt.Errorf("column '%s' not found in card table '%s'", columnName, currentCardTable.Title)
+				if err := cardOps.MoveCardToPosition(f.Context(), resolvedProjectID, cardID, targetColumnID, position); err != nil {
+					return err
 				}
+				fmt.Printf("✓ Moved card #%d to position %d in column '%s' on card table '%s'\n", cardID, position, targetColumn.Title, currentCardTable.Title)
+				return nil
 			}
 
 			// Move the card
@@ -171,16 +223,7 @@ Examples:
 				return fmt.Errorf("failed to move card: %w", err)
 			}
 
-			// Get the column name for the success message
-			var targetColumnName string
-			for _, column := range currentCardTable.Lists {
-				if column.ID == targetColumnID {
-					targetColumnName = column.Title
-					break
-				}
-			}
-
-			fmt.Printf("✓ Moved card #%d to column '%s' on card table '%s'\n", cardID, targetColumnName, currentCardTable.Title)
+			fmt.Printf("✓ Moved card #%d to column '%s' on card table '%s'\n", cardID, targetColumn.Title, currentCardTable.Title)
 
 			return nil
 		},
@@ -190,10 +233,68 @@ Examples:
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 	cmd.Flags().BoolVar(&onHold, "on-hold", false, "Move card to the on-hold section of its current (or target) column")
+	cmd.Flags().BoolVar(&force, "force", false, "Move the card even if it takes the column over its WIP limit")
+	cmd.Flags().IntVar(&placement.position, "position", 0, "Position in the column, starting at 1 for the top")
+	cmd.Flags().BoolVar(&placement.top, "top", false, "Move the card to the top of the column")
+	cmd.Flags().BoolVar(&placement.bottom, "bottom", false, "Move the card to the bottom of the column")
+	cmd.Flags().StringVar(&before, "before", "", "Place the card just above another card (ID or URL)")
+	cmd.Flags().StringVar(&after, "after", "", "Place the card just below another card (ID or URL)")
+	cmd.MarkFlagsMutuallyExclusive("position", "top", "bottom", "before", "after")
+	cmd.Flags().StringVar(&transfer.toTable, "to-table", "", "Move to another card table (ID, name, or URL), recreating the card there")
+	cmd.Flags().StringVar(&transfer.toProject, "to-project", "", "Move to a card table in another project (ID, name, or URL)")
+	cmd.Flags().BoolVar(&transfer.withComments, "with-comments", false, "Copy comments as quoted history when moving to another card table")
 
 	return cmd
 }
 
+// cardPlacement is where a card goes within a column. At most one field is
+// set; before and after are card IDs.
+type cardPlacement struct {
+	position int
+	top      bool
+	bottom   bool
+	before   int64
+	after    int64
+}
+
+func (p *cardPlacement) set() bool {
+	return p.position > 0 || p.top || p.bottom || p.before != 0 || p.after != 0
+}
+
+// resolve returns the 1-based position of the card among the cards of its
+// target column. Positions past the bottom put it at the bottom.
+func (p *cardPlacement) resolve(cards []api.Card, cardID int64) (int, error) {
+	var others []int64
+	for _, card := range cards {
+		if card.ID != cardID {
+			others = append(others, card.ID)
+		}
+	}
+
+	switch {
+	case p.top:
+		return 1, nil
+	case p.bottom:
+		return len(others) + 1, nil
+	case p.position > 0:
+		return min(p.position, len(others)+1), nil
+	}
+
+	relativeTo, offset := p.before, 1
+	if p.after != 0 {
+		relativeTo, offset = p.after, 2
+	}
+	if relativeTo == cardID {
+		return 0, fmt.Errorf("can't place a card relative to itself")
+	}
+	for i, id := range others {
+		if id == relativeTo {
+			return i + offset, nil
+		}
+	}
+	return 0, fmt.Errorf("card #%d isn't in the target column", relativeTo)
+}
+
 // findColumn resolves the target column from --column flag or falls back to the card's current column.
 func findColumn(cardTable *api.CardTable, columnName string, card *api.Card) (*api.Column, error) {
 	if columnName != "" {
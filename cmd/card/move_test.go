@@ -444,3 +444,37 @@ func TestEmptyCardTables(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "no card tables found in project")
 }
+
+func TestCardPlacementResolve(t *testing.T) {
+	cards := []api.Card{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+
+	tests := []struct {
+		name      string
+		placement cardPlacement
+		want      int
+		err       string
+	}{
+		{name: "top", placement: cardPlacement{top: true}, want: 1},
+		{name: "bottom", placement: cardPlacement{bottom: true}, want: 4},
+		{name: "position", placement: cardPlacement{position: 2}, want: 2},
+		{name: "position past the bottom", placement: cardPlacement{position: 9}, want: 4},
+		{name: "before", placement: cardPlacement{before: 4}, want: 3},
+		{name: "after", placement: cardPlacement{after: 1}, want: 2},
+		{name: "after the last card", placement: cardPlacement{after: 4}, want: 4},
+		{name: "itself", placement: cardPlacement{before: 2}, err: "relative to itself"},
+		{name: "other column", placement: cardPlacement{after: 99}, err: "#99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Card 2 is the one being moved
+			got, err := tt.placement.resolve(cards, 2)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
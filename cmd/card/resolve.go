@@ -0,0 +1,96 @@
+package card
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// withCardTableURL points the factory at the account and project of a card
+// table URL. Other identifiers leave it unchanged.
+func withCardTableURL(f *factory.Factory, identifier string) (*factory.Factory, error) {
+	if !parser.IsBasecampURL(identifier) {
+		return f, nil
+	}
+	parsed, err := parser.ParseBasecampURL(identifier)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+	}
+	if parsed.AccountID > 0 {
+		f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
+	}
+	if parsed.ProjectID > 0 {
+		f = f.WithProject(strconv.FormatInt(parsed.ProjectID, 10))
+	}
+	return f, nil
+}
+
+// resolveCardTable finds a card table by ID, URL, or name. Without an
+// identifier it falls back to the default card table set with 'bc4 card set',
+// then to the project's card table.
+func resolveCardTable(f *factory.Factory, client *api.ModularClient, projectID string, identifier string) (*api.CardTable, error) {
+	cardOps := client.Cards()
+
+	if identifier == "" {
+		cfg, err := f.Config()
+		if err != nil {
+			return nil, err
+		}
+		accountID, err := f.AccountID()
+		if err != nil {
+			return nil, err
+		}
+		if acc, ok := cfg.Accounts[accountID]; ok {
+			if proj, ok := acc.ProjectDefaults[projectID]; ok && proj.DefaultCardTable != "" {
+				identifier = proj.DefaultCardTable
+			}
+		}
+		if identifier == "" {
+			cardTable, err := cardOps.GetProjectCardTable(f.Context(), projectID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch card table: %w", err)
+			}
+			return cardTable, nil
+		}
+	}
+
+	if parser.IsBasecampURL(identifier) {
+		parsed, err := parser.ParseBasecampURL(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ResourceType != parser.ResourceTypeCardTable {
+			return nil, fmt.Errorf("URL is not a card table URL: %s", identifier)
+		}
+		identifier = strconv.FormatInt(parsed.ResourceID, 10)
+	}
+
+	if id, err := strconv.ParseInt(identifier, 10, 64); err == nil {
+		cardTable, err := cardOps.GetCardTable(f.Context(), projectID, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch card table %d: %w", id, err)
+		}
+		return cardTable, nil
+	}
+
+	cardTables, err := cardOps.GetAllProjectCardTables(f.Context(), projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch card tables: %w", err)
+	}
+	for _, cardTable := range cardTables {
+		if strings.EqualFold(cardTable.Title, identifier) {
+			return cardTable, nil
+		}
+	}
+	for _, cardTable := range cardTables {
+		if strings.Contains(strings.ToLower(cardTable.Title), strings.ToLower(identifier)) {
+			return cardTable, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no card table found matching '%s'", identifier)
+}
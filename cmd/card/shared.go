@@ -47,3 +47,52 @@ func handleAssigneeSelection(peopleList list.Model, selectedAssignees []int64, m
 	peopleList, cmd = peopleList.Update(msg)
 	return peopleList, selectedAssignees, cmd
 }
+
+// containsID reports whether ids contains id
+func containsID(ids []int64, id int64) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+// positionMove puts a step or card at a 0-based position
+type positionMove struct {
+	id       int64
+	position int
+}
+
+// positionMoves returns the moves that turn the order current into desired,
+// one item at a time from the top. IDs missing from either side are left
+// where they are.
+func positionMoves(current, desired []int64) []positionMove {
+	present := make(map[int64]bool, len(current))
+	for _, id := range current {
+		present[id] = true
+	}
+
+	order := append([]int64(nil), current...)
+	var moves []positionMove
+	pos := 0
+	for _, id := range desired {
+		if !present[id] {
+			continue
+		}
+		if order[pos] != id {
+			from := pos
+			for i := pos; i < len(order); i++ {
+				if order[i] == id {
+					from = i
+					break
+				}
+			}
+			copy(order[pos+1:from+1], order[pos:from])
+			order[pos] = id
+			moves = append(moves, positionMove{id: id, position: pos})
+		}
+		pos++
+	}
+	return moves
+}
@@ -23,6 +23,7 @@ func newStepCmd(f *factory.Factory) *cobra.Command {
 	cmd.AddCommand(newStepCheckCmd(f))
 	cmd.AddCommand(newStepUncheckCmd(f))
 	cmd.AddCommand(newStepEditCmd(f))
+	cmd.AddCommand(newStepImportCmd(f))
 	cmd.AddCommand(newStepMoveCmd(f))
 	cmd.AddCommand(newStepAssignCmd(f))
 	cmd.AddCommand(newStepDeleteCmd(f))
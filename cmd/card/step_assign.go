@@ -150,7 +150,7 @@ Examples:
 			// Update the step
 			req := api.StepUpdateRequest{
 				Title:     currentStep.Title, // Preserve title
-				Assignees: assignees,
+				Assignees: &assignees,
 			}
 
 			_, err = client.Steps().UpdateStep(f.Context(), resolvedProjectID, stepID, req)
@@ -0,0 +1,153 @@
+package card
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// renderStepChecklist writes a card's steps as a Markdown checklist with a
+// bc4 marker on each step
+func renderStepChecklist(card *api.Card, people *utils.Mentions) (string, error) {
+	items := make([]checklist.Item, len(card.Steps))
+	for i, step := range card.Steps {
+		item := checklist.Item{ID: step.ID, Title: step.Title, Completed: step.Completed}
+		if step.DueOn != nil {
+			item.DueOn = *step.DueOn
+		}
+		for _, person := range step.Assignees {
+			item.Assignees = append(item.Assignees, people.Mention(person))
+		}
+		items[i] = item
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<!-- Steps of the card %q. One step per line: - [ ] title @person due:YYYY-MM-DD\n", card.Title)
+	buf.WriteString("     Add, delete and reorder lines, tick boxes and change assignees; keep the bc4 markers. -->\n\n")
+	if err := checklist.Render(&buf, items); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// stepTitle flattens a checklist item into a step title, keeping its group
+// as a prefix since steps aren't grouped
+func stepTitle(item checklist.Item) string {
+	if item.Group == "" {
+		return item.Title
+	}
+	return item.Group + ": " + item.Title
+}
+
+// editedStep is a step as it should be after an edit. ID is 0 for new steps.
+type editedStep struct {
+	ID          int64
+	Title       string
+	DueOn       string
+	Completed   bool
+	AssigneeIDs []int64
+}
+
+// stepEditPlan is the difference between a card's steps and an edited
+// checklist
+type stepEditPlan struct {
+	final    []editedStep // every step in its new order
+	deletes  []api.Step
+	updates  []editedStep // title, due date or assignees changed
+	toggles  []editedStep // completion changed
+	creates  int
+	reorders bool
+}
+
+func (p *stepEditPlan) empty() bool {
+	return len(p.deletes) == 0 && len(p.updates) == 0 && len(p.toggles) == 0 && p.creates == 0 && !p.reorders
+}
+
+// planStepEdit compares a card's steps with their edited form
+func planStepEdit(steps []api.Step, edited []editedStep) (*stepEditPlan, error) {
+	current := make(map[int64]api.Step, len(steps))
+	for _, step := range steps {
+		current[step.ID] = step
+	}
+
+	plan := &stepEditPlan{final: edited}
+	seen := make(map[int64]bool)
+	var keptOrder []int64
+	for _, e := range edited {
+		if e.ID == 0 {
+			plan.creates++
+			continue
+		}
+		step, ok := current[e.ID]
+		if !ok {
+			return nil, fmt.Errorf("step #%d isn't on this card; remove its bc4 marker to add it as a new step", e.ID)
+		}
+		if seen[e.ID] {
+			return nil, fmt.Errorf("step #%d appears more than once", e.ID)
+		}
+		seen[e.ID] = true
+		keptOrder = append(keptOrder, e.ID)
+
+		due := ""
+		if step.DueOn != nil {
+			due = *step.DueOn
+		}
+		var assignees []int64
+		for _, person := range step.Assignees {
+			assignees = append(assignees, person.ID)
+		}
+		if e.Title != step.Title || e.DueOn != due || !sameIDs(e.AssigneeIDs, assignees) {
+			plan.updates = append(plan.updates, e)
+		}
+		if e.Completed != step.Completed {
+			plan.toggles = append(plan.toggles, e)
+		}
+	}
+
+	var remaining []int64
+	for _, step := range steps {
+		if seen[step.ID] {
+			remaining = append(remaining, step.ID)
+		} else {
+			plan.deletes = append(plan.deletes, step)
+		}
+	}
+
+	// New steps are added at the end, so anything but trailing new steps
+	// needs a reorder
+	plan.reorders = !equalIDs(keptOrder, remaining)
+	if !plan.reorders && plan.creates > 0 {
+		for i := len(edited) - plan.creates; i < len(edited); i++ {
+			if i < 0 || edited[i].ID != 0 {
+				plan.reorders = true
+				break
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+func sameIDs(a, b []int64) bool {
+	x := append([]int64(nil), a...)
+	y := append([]int64(nil), b...)
+	sort.Slice(x, func(i, j int) bool { return x[i] < x[j] })
+	sort.Slice(y, func(i, j int) bool { return y[i] < y[j] })
+	return equalIDs(x, y)
+}
+
+func equalIDs(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+package card
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+func TestRenderStepChecklistRoundTrip(t *testing.T) {
+	due := "2025-04-01"
+	card := &api.Card{Title: "Release", Steps: []api.Step{
+		{ID: 10, Title: "Write notes", DueOn: &due, Assignees: []api.Person{{ID: 1, Name: "Jane"}}},
+		{ID: 11, Title: "Tag release", Completed: true},
+	}}
+	people := utils.NewMentions([]api.Person{{ID: 1, Name: "Jane"}}, nil)
+
+	text, err := renderStepChecklist(card, people)
+	require.NoError(t, err)
+
+	items, err := checklist.ParseMarkdown(strings.NewReader(text))
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, checklist.Item{ID: 10, Title: "Write notes", DueOn: due, Assignees: []string{"@jane"}}, items[0])
+	assert.Equal(t, checklist.Item{ID: 11, Title: "Tag release", Completed: true}, items[1])
+}
+
+func TestPlanStepEdit(t *testing.T) {
+	due := "2025-04-01"
+	steps := []api.Step{
+		{ID: 1, Title: "A"},
+		{ID: 2, Title: "B", DueOn: &due, Assignees: []api.Person{{ID: 7}}},
+		{ID: 3, Title: "C", Completed: true},
+		{ID: 4, Title: "D"},
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		plan, err := planStepEdit(steps, []editedStep{
+			{ID: 1, Title: "A"},
+			{ID: 2, Title: "B", DueOn: due, AssigneeIDs: []int64{7}},
+			{ID: 3, Title: "C", Completed: true},
+			{ID: 4, Title: "D"},
+		})
+		require.NoError(t, err)
+		assert.True(t, plan.empty())
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		plan, err := planStepEdit(steps, []editedStep{
+			{ID: 3, Title: "C"},
+			{ID: 1, Title: "A2"},
+			{Title: "New"},
+			{ID: 2, Title: "B", AssigneeIDs: []int64{7, 8}},
+		})
+		require.NoError(t, err)
+		require.Len(t, plan.deletes, 1)
+		assert.Equal(t, int64(4), plan.deletes[0].ID)
+		require.Len(t, plan.updates, 2)
+		assert.Equal(t, int64(1), plan.updates[0].ID)
+		assert.Equal(t, int64(2), plan.updates[1].ID)
+		require.Len(t, plan.toggles, 1)
+		assert.Equal(t, int64(3), plan.toggles[0].ID)
+		assert.Equal(t, 1, plan.creates)
+		assert.True(t, plan.reorders)
+	})
+
+	t.Run("new steps at the end need no reorder", func(t *testing.T) {
+		plan, err := planStepEdit(steps, []editedStep{
+			{ID: 1, Title: "A"},
+			{ID: 3, Title: "C", Completed: true},
+			{Title: "E"},
+		})
+		require.NoError(t, err)
+		assert.False(t, plan.reorders)
+		assert.Len(t, plan.deletes, 2)
+	})
+
+	t.Run("unassigning everyone", func(t *testing.T) {
+		plan, err := planStepEdit(steps[1:2], []editedStep{{ID: 2, Title: "B", DueOn: due}})
+		require.NoError(t, err)
+		require.Len(t, plan.updates, 1)
+
+		assignees := formatIDs(plan.updates[0].AssigneeIDs)
+		body, err := json.Marshal(api.StepUpdateRequest{Title: "B", Assignees: &assignees})
+		require.NoError(t, err)
+		assert.Contains(t, string(body), `"assignees":""`)
+	})
+
+	t.Run("unknown step", func(t *testing.T) {
+		_, err := planStepEdit(steps, []editedStep{{ID: 99, Title: "X"}})
+		assert.ErrorContains(t, err, "#99")
+	})
+
+	t.Run("duplicate step", func(t *testing.T) {
+		_, err := planStepEdit(steps, []editedStep{{ID: 1, Title: "A"}, {ID: 1, Title: "A"}})
+		assert.ErrorContains(t, err, "more than once")
+	})
+}
+
+func TestPositionMoves(t *testing.T) {
+	apply := func(order []int64, moves []positionMove) []int64 {
+		order = append([]int64(nil), order...)
+		for _, move := range moves {
+			for i, id := range order {
+				if id == move.id {
+					order = append(order[:i], order[i+1:]...)
+					break
+				}
+			}
+			order = append(order[:move.position], append([]int64{move.id}, order[move.position:]...)...)
+		}
+		return order
+	}
+
+	tests := []struct {
+		name    string
+		current []int64
+		desired []int64
+		moves   int
+	}{
+		{name: "in order", current: []int64{1, 2, 3}, desired: []int64{1, 2, 3}, moves: 0},
+		{name: "last to front", current: []int64{1, 2, 3}, desired: []int64{3, 1, 2}, moves: 1},
+		{name: "new step into the middle", current: []int64{1, 2, 9}, desired: []int64{1, 9, 2}, moves: 1},
+		{name: "reversed", current: []int64{1, 2, 3, 4}, desired: []int64{4, 3, 2, 1}, moves: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves := positionMoves(tt.current, tt.desired)
+			assert.Len(t, moves, tt.moves)
+			assert.Equal(t, tt.desired, apply(tt.current, moves))
+		})
+	}
+}
@@ -17,9 +17,14 @@ func newStepEditCmd(f *factory.Factory) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "edit [CARD_ID or URL] [STEP_ID or URL]",
-		Short: "Edit a step's content",
+		Short: "Edit a step's content, or all of a card's steps in your editor",
 		Long: `Edit the content of an existing step (subtask).
 
+With --editor and just a card, every step of the card opens in your editor as
+a Markdown checklist. Add, delete and reorder lines, tick or untick boxes,
+and change @assignees and due:YYYY-MM-DD dates; the changes are applied when
+you save and close the file.
+
 You can specify the card and step using either:
 - Numeric IDs (e.g., "123 456" for card 123, step 456)
 - A Basecamp step URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345/steps/67890")
@@ -27,12 +32,21 @@ You can specify the card and step using either:
 Examples:
   bc4 card step edit 123 456 --content "Updated step description"
   bc4 card step edit 123 456 --interactive
+  bc4 card step edit 123 --editor
   bc4 card step edit https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/12345/steps/67890 --content "New content"`,
 		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var cardID, stepID int64
 			var parsedURL *parser.ParsedURL
 
+			editor, _ := cmd.Flags().GetBool("editor")
+			if editor {
+				if len(args) != 1 {
+					return fmt.Errorf("--editor takes a card ID or URL only")
+				}
+				return runStepEditEditor(f, accountID, projectID, args[0])
+			}
+
 			// Parse arguments - could be card ID + step ID, or a single step URL
 			if len(args) == 1 {
 				// Single argument - must be a step URL
@@ -154,8 +168,45 @@ Examples:
 	// TODO: Add flags for content and interactive mode
 	cmd.Flags().String("content", "", "New content for the step")
 	cmd.Flags().Bool("interactive", false, "Open interactive editor")
+	cmd.Flags().Bool("editor", false, "Edit all steps of the card as a Markdown checklist in your editor")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
 
 	return cmd
 }
+
+// runStepEditEditor resolves the card for 'step edit --editor'
+func runStepEditEditor(f *factory.Factory, accountID, projectID, arg string) error {
+	cardID, parsedURL, err := parser.ParseArgument(arg)
+	if err != nil {
+		return fmt.Errorf("invalid card ID or URL: %s", arg)
+	}
+
+	if accountID != "" {
+		f = f.WithAccount(accountID)
+	}
+	if projectID != "" {
+		f = f.WithProject(projectID)
+	}
+	if parsedURL != nil {
+		if parsedURL.ResourceType != parser.ResourceTypeCard {
+			return fmt.Errorf("URL is not for a card: %s", arg)
+		}
+		if parsedURL.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+		}
+		if parsedURL.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+		}
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	resolvedProjectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+	return editStepsInEditor(f, client, resolvedProjectID, cardID)
+}
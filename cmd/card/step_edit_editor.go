@@ -0,0 +1,156 @@
+package card
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// editStepsInEditor opens every step of a card in the user's editor as one
+// Markdown checklist and applies the differences
+func editStepsInEditor(f *factory.Factory, client *api.ModularClient, projectID string, cardID int64) error {
+	if !ui.IsTerminal(os.Stdin) || !ui.IsTerminal(os.Stdout) {
+		return fmt.Errorf("--editor requires an interactive terminal")
+	}
+
+	ctx := f.Context()
+	stepOps := client.Steps()
+
+	card, err := client.Cards().GetCard(ctx, projectID, cardID)
+	if err != nil {
+		return fmt.Errorf("failed to get card: %w", err)
+	}
+	people, err := client.People().GetProjectPeople(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project people: %w", err)
+	}
+	handles := utils.NewMentions(people, utils.NewUserResolver(client.Client, projectID))
+
+	original, err := renderStepChecklist(card, handles)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	edited, err := utils.EditInEditor(utils.ResolveEditor(cfg.Preferences.Editor), fmt.Sprintf("bc4-card-%d-steps-*.md", card.ID), original)
+	if err != nil {
+		return err
+	}
+	if edited == original {
+		return fmt.Errorf("no changes made, edit aborted")
+	}
+
+	items, err := checklist.ParseMarkdown(strings.NewReader(edited))
+	if err != nil {
+		return err
+	}
+	after := make([]editedStep, len(items))
+	for i, item := range items {
+		if item.Description != "" {
+			return fmt.Errorf("step %q has indented lines below it; steps are a single line", item.Title)
+		}
+		after[i] = editedStep{ID: item.ID, Title: stepTitle(item), DueOn: item.DueOn, Completed: item.Completed}
+		if after[i].AssigneeIDs, err = handles.Resolve(ctx, item.Assignees); err != nil {
+			return fmt.Errorf("failed to resolve assignees of step %q: %w", item.Title, err)
+		}
+	}
+
+	plan, err := planStepEdit(card.Steps, after)
+	if err != nil {
+		return err
+	}
+	if plan.empty() {
+		return fmt.Errorf("no changes made, edit aborted")
+	}
+
+	for _, step := range plan.deletes {
+		if err := stepOps.DeleteStep(ctx, projectID, step.ID); err != nil {
+			return fmt.Errorf("failed to delete step %q: %w", step.Title, err)
+		}
+	}
+	for _, e := range plan.updates {
+		// Send every field so nothing is cleared by omission; an empty
+		// assignee list unassigns everyone
+		due := e.DueOn
+		assignees := formatIDs(e.AssigneeIDs)
+		req := api.StepUpdateRequest{Title: e.Title, DueOn: &due, Assignees: &assignees}
+		if _, err := stepOps.UpdateStep(ctx, projectID, e.ID, req); err != nil {
+			return fmt.Errorf("failed to update step %q: %w", e.Title, err)
+		}
+	}
+	for _, e := range plan.toggles {
+		if err := stepOps.SetStepCompletion(ctx, projectID, e.ID, e.Completed); err != nil {
+			return fmt.Errorf("failed to update step %q: %w", e.Title, err)
+		}
+	}
+	for i := range plan.final {
+		e := &plan.final[i]
+		if e.ID != 0 {
+			continue
+		}
+		req := api.StepCreateRequest{Title: e.Title, Assignees: formatIDs(e.AssigneeIDs)}
+		if e.DueOn != "" {
+			due := e.DueOn
+			req.DueOn = &due
+		}
+		step, err := stepOps.CreateStep(ctx, projectID, cardID, req)
+		if err != nil {
+			return fmt.Errorf("failed to create step %q: %w", e.Title, err)
+		}
+		e.ID = step.ID
+		if e.Completed {
+			if err := stepOps.SetStepCompletion(ctx, projectID, step.ID, true); err != nil {
+				return fmt.Errorf("failed to complete step %q: %w", e.Title, err)
+			}
+		}
+	}
+
+	moved := 0
+	if plan.reorders {
+		fresh, err := client.Cards().GetCard(ctx, projectID, cardID)
+		if err != nil {
+			return fmt.Errorf("failed to get card: %w", err)
+		}
+		current := make([]int64, len(fresh.Steps))
+		for i, step := range fresh.Steps {
+			current[i] = step.ID
+		}
+		desired := make([]int64, len(plan.final))
+		for i, e := range plan.final {
+			desired[i] = e.ID
+		}
+		for _, move := range positionMoves(current, desired) {
+			if err := stepOps.MoveStep(ctx, projectID, cardID, move.id, move.position); err != nil {
+				return fmt.Errorf("failed to move step #%d: %w", move.id, err)
+			}
+			moved++
+		}
+	}
+
+	var parts []string
+	for _, part := range []struct {
+		count int
+		label string
+	}{
+		{plan.creates, "added"},
+		{len(plan.deletes), "deleted"},
+		{len(plan.updates), "edited"},
+		{len(plan.toggles), "checked or unchecked"},
+		{moved, "moved"},
+	} {
+		if part.count > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", part.count, part.label))
+		}
+	}
+	fmt.Printf("✓ Updated steps of card #%d: %s\n", cardID, strings.Join(parts, ", "))
+	return nil
+}
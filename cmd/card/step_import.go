@@ -0,0 +1,212 @@
+package card
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// newStepImportCmd creates the step import command
+func newStepImportCmd(f *factory.Factory) *cobra.Command {
+	var accountID string
+	var projectID string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import [CARD_ID or URL] <file>",
+		Short: "Add steps to a card from a Markdown checklist",
+		Long: `Add steps to a card from a Markdown checklist. Use "-" to read from stdin.
+
+Each "- [ ]" or "- [x]" item becomes a step, completed if it's ticked.
+@name in an item assigns the step and due:YYYY-MM-DD sets its due date.
+Items under a heading get the heading as a prefix, since steps aren't grouped.
+
+Importing is idempotent: items whose title matches an existing step on the
+card are skipped.`,
+		Example: `  # Add a release checklist to a card
+  bc4 card step import 12345 release.md
+
+  # Preview what would be added
+  bc4 card step import 12345 release.md --dry-run
+
+  # Pipe a checklist in
+  printf -- '- [ ] Write notes @jane due:2025-04-01\n- [x] Tag release\n' | bc4 card step import 12345 -`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Parse card ID (could be numeric ID or URL)
+			cardID, parsedURL, err := parser.ParseArgument(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid card ID or URL: %s", args[0])
+			}
+
+			// Apply overrides if specified
+			if accountID != "" {
+				f = f.WithAccount(accountID)
+			}
+			if projectID != "" {
+				f = f.WithProject(projectID)
+			}
+
+			// If a URL was parsed, override account and project IDs if provided
+			if parsedURL != nil {
+				if parsedURL.ResourceType != parser.ResourceTypeCard {
+					return fmt.Errorf("URL is not for a card: %s", args[0])
+				}
+				if parsedURL.AccountID > 0 {
+					f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+				}
+				if parsedURL.ProjectID > 0 {
+					f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+				}
+			}
+
+			return runStepImport(f, cardID, args[1], dryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would be added without making changes")
+	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+func runStepImport(f *factory.Factory, cardID int64, path string, dryRun bool) error {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		r = file
+	}
+
+	items, err := checklist.ParseMarkdown(r)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("no steps found in %s", path)
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	ctx := f.Context()
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	card, err := client.Cards().GetCard(ctx, projectID, cardID)
+	if err != nil {
+		return fmt.Errorf("failed to get card: %w", err)
+	}
+
+	existing := make(map[string]bool, len(card.Steps))
+	for _, step := range card.Steps {
+		existing[stepImportKey(step.Title)] = true
+	}
+	var create []checklist.Item
+	skipped := 0
+	for _, item := range items {
+		item.Title = stepTitle(item)
+		key := stepImportKey(item.Title)
+		if existing[key] {
+			skipped++
+			continue
+		}
+		existing[key] = true
+		create = append(create, item)
+	}
+
+	// Resolve assignees up front so a typo doesn't leave a half-done import
+	people, err := client.People().GetProjectPeople(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project people: %w", err)
+	}
+	handles := utils.NewMentions(people, utils.NewUserResolver(client.Client, projectID))
+	assignees := make([][]int64, len(create))
+	for i, item := range create {
+		if assignees[i], err = handles.Resolve(ctx, item.Assignees); err != nil {
+			return fmt.Errorf("failed to resolve assignees of step %q: %w", item.Title, err)
+		}
+	}
+
+	if dryRun {
+		fmt.Printf("Would add to card %q:\n", card.Title)
+		for _, item := range create {
+			fmt.Printf("  + step %q%s\n", item.Title, describeStepItem(item))
+		}
+		if skipped > 0 {
+			fmt.Printf("\n%d to add, %d already exist\n", len(create), skipped)
+		} else {
+			fmt.Printf("\n%d to add\n", len(create))
+		}
+		return nil
+	}
+
+	tty := ui.IsTerminal(os.Stdout)
+	for i, item := range create {
+		req := api.StepCreateRequest{Title: item.Title, Assignees: formatIDs(assignees[i])}
+		if item.DueOn != "" {
+			due := item.DueOn
+			req.DueOn = &due
+		}
+		step, err := client.Steps().CreateStep(ctx, projectID, cardID, req)
+		if err != nil {
+			return fmt.Errorf("failed to create step %q: %w", item.Title, err)
+		}
+		if item.Completed {
+			if err := client.Steps().SetStepCompletion(ctx, projectID, step.ID, true); err != nil {
+				return fmt.Errorf("failed to complete step #%d: %w", step.ID, err)
+			}
+		}
+		if !tty {
+			fmt.Println(step.ID)
+		}
+	}
+
+	if tty {
+		fmt.Printf("✓ Added %d steps to card #%d\n", len(create), cardID)
+		if skipped > 0 {
+			fmt.Printf("  Skipped %d steps that already exist\n", skipped)
+		}
+	}
+	return nil
+}
+
+// stepImportKey identifies a step title for duplicate detection
+func stepImportKey(title string) string {
+	return strings.ToLower(strings.TrimSpace(title))
+}
+
+func describeStepItem(item checklist.Item) string {
+	var parts []string
+	if item.DueOn != "" {
+		parts = append(parts, "due "+item.DueOn)
+	}
+	if len(item.Assignees) > 0 {
+		parts = append(parts, strings.Join(item.Assignees, ", "))
+	}
+	if item.Completed {
+		parts = append(parts, "completed")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, "; ") + ")"
+}
@@ -204,5 +204,8 @@ If no table ID or name is provided, uses the default card table if set.`,
 	cmd.Flags().StringVar(&columnFilter, "column", "", "Filter to show only specific column")
 	cmd.Flags().StringVarP(&format, "format", "f", "table", "Output format (table, json, csv)")
 
+	cmd.AddCommand(newTablePlanCmd(f))
+	cmd.AddCommand(newTableApplyCmd(f))
+
 	return cmd
 }
@@ -0,0 +1,269 @@
+package card
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+)
+
+const layoutHelp = `A layout file lists the columns a card table should have, in order:
+
+  columns:
+    - title: Triage
+    - title: Up next
+      color: blue
+      description: Ready to be picked up
+    - title: Doing
+      color: yellow
+      on_hold: true
+    - title: Review
+      renamed_from: QA
+      color: purple
+    - title: Done
+
+Columns are matched by title (ignoring case), or by renamed_from to rename
+them. Settings left out of the file are left as they are on the card table,
+and columns the file doesn't mention are never deleted. The Triage, Done and
+Not Now columns keep their place.`
+
+type layoutOptions struct {
+	accountID string
+	projectID string
+	file      string
+}
+
+func (o *layoutOptions) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", "Layout file (YAML), or - for stdin (required)")
+	cmd.Flags().StringVarP(&o.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&o.projectID, "project", "p", "", "Specify project ID")
+	_ = cmd.MarkFlagRequired("file")
+}
+
+// layoutTarget is a card table and the layout it should have
+type layoutTarget struct {
+	f         *factory.Factory
+	client    *api.ModularClient
+	projectID string
+	table     *api.CardTable
+	layout    *tableLayout
+}
+
+func loadLayoutTarget(f *factory.Factory, opts *layoutOptions, args []string) (*layoutTarget, error) {
+	var data []byte
+	var err error
+	if opts.file == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(opts.file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read layout file: %w", err)
+	}
+	layout, err := parseTableLayout(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.accountID != "" {
+		f = f.WithAccount(opts.accountID)
+	}
+	if opts.projectID != "" {
+		f = f.WithProject(opts.projectID)
+	}
+	identifier := ""
+	if len(args) > 0 {
+		identifier = args[0]
+	}
+	f, err = withCardTableURL(f, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return nil, err
+	}
+	table, err := resolveCardTable(f, client, projectID, identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &layoutTarget{f: f, client: client, projectID: projectID, table: table, layout: layout}, nil
+}
+
+func printLayoutPlan(tableTitle string, plan *layoutPlan) {
+	for _, column := range plan.unmanaged {
+		fmt.Printf("  ? column %q isn't in the layout and is left as it is\n", column.Title)
+	}
+	if len(plan.changes) == 0 {
+		fmt.Printf("%s matches the layout\n", tableTitle)
+		return
+	}
+	fmt.Printf("Changes to %s:\n", tableTitle)
+	for _, change := range plan.changes {
+		fmt.Printf("  %s\n", change)
+	}
+	fmt.Printf("\n%d changes\n", len(plan.changes))
+}
+
+func newTablePlanCmd(f *factory.Factory) *cobra.Command {
+	opts := &layoutOptions{}
+	var exitCode bool
+
+	cmd := &cobra.Command{
+		Use:   "plan [table] -f layout.yml",
+		Short: "Show how a card table differs from a layout file",
+		Long: `Compare the columns of a card table with a layout file and list the changes
+'bc4 card table apply' would make, without changing anything.
+
+` + layoutHelp,
+		Example: `  # Preview changes to the default card table
+  bc4 card table plan -f layout.yml
+
+  # Check every client project, failing if any has drifted
+  for p in $(bc4 project list --format json | jq -r '.[].id'); do
+    bc4 card table plan -p "$p" -f layout.yml --exit-code || echo "$p differs"
+  done`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := loadLayoutTarget(f, opts, args)
+			if err != nil {
+				return err
+			}
+
+			plan := planLayout(target.layout, target.table.Lists)
+			printLayoutPlan(target.table.Title, plan)
+
+			if exitCode && len(plan.changes) > 0 {
+				return cmdutil.NewSilentError(fmt.Errorf("%s differs from the layout", target.table.Title))
+			}
+			return nil
+		},
+	}
+
+	opts.addFlags(cmd)
+	cmd.Flags().BoolVar(&exitCode, "exit-code", false, "Exit with status 1 when there are changes")
+
+	return cmd
+}
+
+func newTableApplyCmd(f *factory.Factory) *cobra.Command {
+	opts := &layoutOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply [table] -f layout.yml",
+		Short: "Bring a card table's columns in line with a layout file",
+		Long: `Create, rename, describe, color, reorder and enable on-hold for the columns
+of a card table so they match a layout file. Only the changes shown by
+'bc4 card table plan' are made.
+
+` + layoutHelp,
+		Example: `  # Apply a layout to the default card table
+  bc4 card table apply -f layout.yml
+
+  # Apply it to a named card table in another project
+  bc4 card table apply "Client work" -p 12345 -f layout.yml`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			target, err := loadLayoutTarget(f, opts, args)
+			if err != nil {
+				return err
+			}
+			return applyLayout(target)
+		},
+	}
+
+	opts.addFlags(cmd)
+
+	return cmd
+}
+
+// applyLayout makes the changes of a layout plan. Columns are created and
+// updated first; moves are then planned again from the card table's actual
+// order, since new columns may not land where the plan assumed.
+func applyLayout(target *layoutTarget) error {
+	ctx := target.f.Context()
+	columnOps := target.client.Columns()
+	projectID := target.projectID
+	table := target.table
+
+	plan := planLayout(target.layout, table.Lists)
+	for _, column := range plan.unmanaged {
+		fmt.Printf("  ? column %q isn't in the layout and is left as it is\n", column.Title)
+	}
+
+	applied := 0
+	created := make(map[string]int64)
+	for _, change := range plan.changes {
+		if change.action == layoutMove {
+			continue
+		}
+		columnID := change.columnID
+		if columnID == 0 {
+			columnID = created[change.title]
+		}
+
+		var err error
+		switch change.action {
+		case layoutCreate:
+			req := api.ColumnCreateRequest{Title: change.title, Color: change.color}
+			if change.description != nil {
+				req.Description = *change.description
+			}
+			var column *api.Column
+			column, err = columnOps.CreateColumn(ctx, projectID, table.ID, req)
+			if err == nil {
+				created[change.title] = column.ID
+			}
+		case layoutUpdate:
+			req := api.ColumnUpdateRequest{Title: change.title, Description: change.description}
+			_, err = columnOps.UpdateColumn(ctx, projectID, columnID, req)
+		case layoutColor:
+			err = columnOps.SetColumnColor(ctx, projectID, columnID, change.color)
+		case layoutHold:
+			err = columnOps.SetColumnOnHold(ctx, projectID, columnID)
+		case layoutUnhold:
+			err = columnOps.RemoveColumnOnHold(ctx, projectID, columnID)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", change, err)
+		}
+		fmt.Printf("  %s\n", change)
+		applied++
+	}
+
+	if applied > 0 {
+		fresh, err := target.client.Cards().GetCardTable(ctx, projectID, table.ID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch card table: %w", err)
+		}
+		plan = planLayout(target.layout, fresh.Lists)
+	}
+	for _, change := range plan.changes {
+		if change.action != layoutMove {
+			continue
+		}
+		if err := columnOps.MoveColumn(ctx, projectID, table.ID, change.columnID, change.targetID, change.position); err != nil {
+			return fmt.Errorf("%s: %w", change, err)
+		}
+		fmt.Printf("  %s\n", change)
+		applied++
+	}
+
+	if applied == 0 {
+		fmt.Printf("%s already matches the layout\n", table.Title)
+		return nil
+	}
+	fmt.Printf("✓ Applied %d changes to %s\n", applied, table.Title)
+	return nil
+}
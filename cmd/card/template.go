@@ -0,0 +1,131 @@
+package card
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/cardtemplate"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/config"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// cardTemplateDirs returns the directories card templates are read from
+func cardTemplateDirs() []string {
+	workDir, _ := os.Getwd()
+	return cardtemplate.Dirs(config.GetConfigDir(), workDir)
+}
+
+// loadCardTemplate finds a card template by name or path
+func loadCardTemplate(name string) (*cardtemplate.Template, error) {
+	return cardtemplate.Find(cardTemplateDirs(), name)
+}
+
+// newTemplateCmd creates the card template command
+func newTemplateCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Work with card templates",
+		Long: `Card templates describe a card to create with 'bc4 card add --template' or
+'bc4 card create --template': a title pattern, Markdown content, default
+column, assignees, a relative due date and a checklist of steps with their
+own assignees and due dates.
+
+Templates are Markdown files with YAML front matter (the content goes below
+it) or YAML files with a body field, named after the template:
+
+  .bc4/templates/cards/bug.md      in the current repository, shared with
+                                   everyone who works on it
+  ~/.config/bc4/templates/cards/   your own templates
+
+Repository templates hide personal ones with the same name.
+
+Example template:
+
+  ---
+  title: "Bug: {{title}}"
+  column: Triage
+  assignees: [jane@example.com]
+  due: +3d
+  steps:
+    - Reproduce
+    - title: Write a failing test
+      assignees: ["@bob"]
+      due: +1d
+    - Fix and release
+  ---
+
+  ## Steps to reproduce
+
+  ## Expected behaviour
+
+{{title}} is replaced by the title given on the command line and {{date}} by
+today's date. Due dates are relative to when the card is created.`,
+	}
+
+	cmdutil.EnableSuggestions(cmd)
+	cmd.AddCommand(newTemplateListCmd(f))
+
+	return cmd
+}
+
+func newTemplateListCmd(f *factory.Factory) *cobra.Command {
+	var formatStr string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List available card templates",
+		Example: `  # List templates from the repository and your config directory
+  bc4 card template list`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := ui.ParseOutputFormat(formatStr)
+			if err != nil {
+				return err
+			}
+
+			dirs := cardTemplateDirs()
+			templates, err := cardtemplate.List(dirs)
+			if err != nil {
+				return err
+			}
+
+			if format == ui.OutputFormatJSON {
+				if templates == nil {
+					templates = []*cardtemplate.Template{}
+				}
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(templates)
+			}
+
+			if len(templates) == 0 {
+				fmt.Printf("No card templates found in %s\n", strings.Join(dirs, " or "))
+				return nil
+			}
+
+			tp := tableprinter.New(os.Stdout)
+			tp.AddHeader("NAME", "TITLE", "COLUMN", "STEPS", "PATH")
+			for _, t := range templates {
+				tp.AddField(t.Name)
+				tp.AddField(t.Title)
+				tp.AddField(t.Column)
+				tp.AddField(strconv.Itoa(len(t.Steps)))
+				tp.AddField(t.Path)
+				tp.EndRow()
+			}
+			return tp.Render()
+		},
+	}
+
+	cmd.Flags().StringVarP(&formatStr, "format", "f", "table", "Output format: table or json")
+
+	return cmd
+}
@@ -0,0 +1,307 @@
+package card
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// transferOptions control how a card is recreated in another card table
+type transferOptions struct {
+	toTable      string
+	toProject    string
+	column       string
+	withComments bool
+	archive      bool
+}
+
+// transferDestination is the column a card is copied or moved to
+type transferDestination struct {
+	projectID   string
+	projectName string // empty when staying in the same project
+	table       *api.CardTable
+	column      *api.Column
+}
+
+func (d *transferDestination) label() string {
+	label := d.table.Title + " › " + d.column.Title
+	if d.projectName != "" {
+		label = d.projectName + " › " + label
+	}
+	return label
+}
+
+// resolveTransferDestination works out the destination project, card table
+// and column. A card table URL carries its project, which takes effect
+// unless --to-project was given. Without --column the card goes to the
+// column with the same name as its current one, or the first column.
+func resolveTransferDestination(f *factory.Factory, client *api.ModularClient, projectID string, card *api.Card, opts *transferOptions) (*transferDestination, error) {
+	dest := &transferDestination{projectID: projectID}
+
+	if opts.toProject != "" {
+		project, err := utils.ResolveProject(f.Context(), client.Projects(), opts.toProject)
+		if err != nil {
+			return nil, err
+		}
+		dest.projectID = strconv.FormatInt(project.ID, 10)
+		dest.projectName = project.Name
+	}
+
+	if parser.IsBasecampURL(opts.toTable) && opts.toProject == "" {
+		parsed, err := parser.ParseBasecampURL(opts.toTable)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ProjectID > 0 {
+			dest.projectID = strconv.FormatInt(parsed.ProjectID, 10)
+		}
+	}
+
+	table, err := resolveCardTable(f.WithProject(dest.projectID), client, dest.projectID, opts.toTable)
+	if err != nil {
+		return nil, err
+	}
+	dest.table = table
+
+	if dest.projectID != projectID && dest.projectName == "" {
+		project, err := client.Projects().GetProject(f.Context(), dest.projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch project: %w", err)
+		}
+		dest.projectName = project.Name
+	}
+
+	if opts.column != "" {
+		dest.column, err = findColumn(table, opts.column, card)
+		if err != nil {
+			return nil, err
+		}
+		return dest, nil
+	}
+
+	dest.column = defaultTransferColumn(table, card)
+	if dest.column == nil {
+		return nil, fmt.Errorf("card table '%s' has no columns", table.Title)
+	}
+	return dest, nil
+}
+
+// defaultTransferColumn picks the column matching the card's current column
+// by name, falling back to the first column of the table
+func defaultTransferColumn(table *api.CardTable, card *api.Card) *api.Column {
+	if len(table.Lists) == 0 {
+		return nil
+	}
+	if card.Parent != nil {
+		for i := range table.Lists {
+			if strings.EqualFold(table.Lists[i].Title, card.Parent.Title) {
+				return &table.Lists[i]
+			}
+		}
+	}
+	return &table.Lists[0]
+}
+
+// cardTransfer recreates a card in another card table, carrying over its
+// title, content, due date, assignees, steps and optionally its comments
+type cardTransfer struct {
+	f               *factory.Factory
+	client          *api.ModularClient
+	sourceProjectID string
+	dest            *transferDestination
+	peopleByEmail   map[string]int64
+}
+
+func (t *cardTransfer) warn(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", args...)
+}
+
+// content re-uploads attachments when the card leaves its project, since
+// attachments can't be shared between projects
+func (t *cardTransfer) content(content string) string {
+	if t.dest.projectID == t.sourceProjectID {
+		return content
+	}
+	rehosted, errs := utils.RehostAttachments(t.f.Context(), t.client.Uploads(), t.client.Attachments(), t.sourceProjectID, content)
+	for _, err := range errs {
+		t.warn("attachment not copied: %v", err)
+	}
+	return rehosted
+}
+
+// mapPeople maps people to the destination project by email address. Within
+// the same project IDs carry over unchanged.
+func (t *cardTransfer) mapPeople(people []api.Person) []int64 {
+	var ids []int64
+	for _, person := range people {
+		if t.dest.projectID == t.sourceProjectID {
+			ids = append(ids, person.ID)
+			continue
+		}
+		if id, ok := t.peopleByEmail[strings.ToLower(person.EmailAddress)]; ok {
+			ids = append(ids, id)
+		} else {
+			t.warn("%s has no access to the destination project and was left unassigned", person.Name)
+		}
+	}
+	return ids
+}
+
+func (t *cardTransfer) run(card *api.Card, withComments bool) (*api.Card, error) {
+	ctx := t.f.Context()
+	cardOps := t.client.Cards()
+
+	if t.dest.projectID != t.sourceProjectID {
+		people, err := t.client.People().GetProjectPeople(ctx, t.dest.projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch destination project people: %w", err)
+		}
+		t.peopleByEmail = make(map[string]int64, len(people))
+		for _, person := range people {
+			if person.EmailAddress != "" {
+				t.peopleByEmail[strings.ToLower(person.EmailAddress)] = person.ID
+			}
+		}
+	}
+
+	newCard, err := cardOps.CreateCard(ctx, t.dest.projectID, t.dest.column.ID, api.CardCreateRequest{
+		Title:   card.Title,
+		Content: t.content(card.Content),
+		DueOn:   card.DueOn,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create card in destination: %w", err)
+	}
+
+	if assignees := t.mapPeople(card.Assignees); len(assignees) > 0 {
+		if _, err := cardOps.UpdateCard(ctx, t.dest.projectID, newCard.ID, api.CardUpdateRequest{AssigneeIDs: assignees}); err != nil {
+			t.warn("failed to assign the new card: %v", err)
+		}
+	}
+
+	for _, step := range card.Steps {
+		req := api.StepCreateRequest{Title: step.Title, DueOn: step.DueOn}
+		if ids := t.mapPeople(step.Assignees); len(ids) > 0 {
+			req.Assignees = formatIDs(ids)
+		}
+		newStep, err := t.client.Steps().CreateStep(ctx, t.dest.projectID, newCard.ID, req)
+		if err != nil {
+			return nil, t.discard(newCard, fmt.Errorf("failed to copy step %q: %w", step.Title, err))
+		}
+		if step.Completed {
+			if err := t.client.Steps().SetStepCompletion(ctx, t.dest.projectID, newStep.ID, true); err != nil {
+				t.warn("failed to complete step %q: %v", step.Title, err)
+			}
+		}
+	}
+
+	if withComments {
+		comments, err := t.client.Comments().ListComments(ctx, t.sourceProjectID, card.ID)
+		if err != nil {
+			return nil, t.discard(newCard, fmt.Errorf("failed to fetch comments: %w", err))
+		}
+		for _, comment := range comments {
+			req := api.CommentCreateRequest{Content: utils.QuoteComment(comment, t.content(comment.Content))}
+			if _, err := t.client.Comments().CreateComment(ctx, t.dest.projectID, newCard.ID, req); err != nil {
+				t.warn("failed to copy comment #%d: %v", comment.ID, err)
+			}
+		}
+	}
+
+	return newCard, nil
+}
+
+// discard trashes a partly copied card after err, so a failed copy leaves
+// nothing behind. If that fails too, the error names the card to clean up.
+func (t *cardTransfer) discard(newCard *api.Card, err error) error {
+	if trashErr := t.client.Activity().TrashRecording(t.f.Context(), t.dest.projectID, newCard.ID); trashErr != nil {
+		return fmt.Errorf("%w; the partly copied card #%d could not be removed: %v", err, newCard.ID, trashErr)
+	}
+	return err
+}
+
+// crossLink leaves a comment on each card pointing at the other
+func (t *cardTransfer) crossLink(original, created *api.Card, verb string) {
+	ctx := t.f.Context()
+	comments := t.client.Comments()
+
+	from := api.CommentCreateRequest{Content: fmt.Sprintf("<div>%s from %s</div>", verb, cardLink(original))}
+	if _, err := comments.CreateComment(ctx, t.dest.projectID, created.ID, from); err != nil {
+		t.warn("failed to link the new card to the original: %v", err)
+	}
+	to := api.CommentCreateRequest{Content: fmt.Sprintf("<div>%s to %s</div>", verb, cardLink(created))}
+	if _, err := comments.CreateComment(ctx, t.sourceProjectID, original.ID, to); err != nil {
+		t.warn("failed to link the original card to the new one: %v", err)
+	}
+}
+
+// cardLink renders a link to a card for use in rich text
+func cardLink(card *api.Card) string {
+	title := html.EscapeString(card.Title)
+	if card.AppURL == "" {
+		return fmt.Sprintf("%s (#%d)", title, card.ID)
+	}
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(card.AppURL), title)
+}
+
+func formatIDs(ids []int64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
+
+// runCardTransfer copies a card to another card table, or moves it there by
+// archiving the original afterwards
+func runCardTransfer(f *factory.Factory, client *api.ModularClient, projectID string, cardID int64, opts *transferOptions, move bool) error {
+	card, err := client.Cards().GetCard(f.Context(), projectID, cardID)
+	if err != nil {
+		return fmt.Errorf("failed to get card: %w", err)
+	}
+
+	dest, err := resolveTransferDestination(f, client, projectID, card, opts)
+	if err != nil {
+		return err
+	}
+	if move && dest.projectID == projectID && card.Parent != nil {
+		for _, column := range dest.table.Lists {
+			if column.ID == card.Parent.ID {
+				return fmt.Errorf("card #%d is already on card table '%s'; use --column to move it between columns", cardID, dest.table.Title)
+			}
+		}
+	}
+
+	transfer := &cardTransfer{f: f, client: client, sourceProjectID: projectID, dest: dest}
+	newCard, err := transfer.run(card, opts.withComments)
+	if err != nil {
+		return err
+	}
+
+	verb := "Copied"
+	if move {
+		verb = "Moved"
+	}
+	transfer.crossLink(card, newCard, verb)
+
+	if move || opts.archive {
+		if err := client.Cards().ArchiveCard(f.Context(), projectID, card.ID); err != nil {
+			return fmt.Errorf("created #%d but failed to archive the original: %w", newCard.ID, err)
+		}
+	}
+
+	if !ui.IsTerminal(os.Stdout) {
+		fmt.Println(newCard.ID)
+		return nil
+	}
+	fmt.Printf("✓ %s card #%d to %s as #%d\n", verb, cardID, dest.label(), newCard.ID)
+	return nil
+}
@@ -0,0 +1,60 @@
+package card
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestDefaultTransferColumn(t *testing.T) {
+	table := &api.CardTable{Lists: []api.Column{
+		{ID: 1, Title: "Triage"},
+		{ID: 2, Title: "In Progress"},
+	}}
+
+	card := &api.Card{Parent: &api.Column{ID: 99, Title: "in progress"}}
+	assert.Equal(t, int64(2), defaultTransferColumn(table, card).ID)
+
+	card = &api.Card{Parent: &api.Column{ID: 98, Title: "Review"}}
+	assert.Equal(t, int64(1), defaultTransferColumn(table, card).ID)
+
+	assert.Equal(t, int64(1), defaultTransferColumn(table, &api.Card{}).ID)
+	assert.Nil(t, defaultTransferColumn(&api.CardTable{}, card))
+}
+
+func TestCardTransfer_MapPeople(t *testing.T) {
+	people := []api.Person{
+		{ID: 1, Name: "Ada", EmailAddress: "Ada@example.com"},
+		{ID: 2, Name: "Grace", EmailAddress: "grace@example.com"},
+	}
+
+	same := &cardTransfer{sourceProjectID: "1", dest: &transferDestination{projectID: "1"}}
+	assert.Equal(t, []int64{1, 2}, same.mapPeople(people))
+
+	other := &cardTransfer{
+		sourceProjectID: "1",
+		dest:            &transferDestination{projectID: "2"},
+		peopleByEmail:   map[string]int64{"ada@example.com": 10},
+	}
+	assert.Equal(t, []int64{10}, other.mapPeople(people))
+}
+
+func TestCardLink(t *testing.T) {
+	assert.Equal(t, `<a href="https://3.basecamp.com/1/buckets/2/card_tables/cards/3">Fix &lt;login&gt;</a>`,
+		cardLink(&api.Card{ID: 3, Title: "Fix <login>", AppURL: "https://3.basecamp.com/1/buckets/2/card_tables/cards/3"}))
+	assert.Equal(t, "Fix it (#3)", cardLink(&api.Card{ID: 3, Title: "Fix it"}))
+}
+
+func TestTransferDestinationLabel(t *testing.T) {
+	dest := &transferDestination{
+		table:  &api.CardTable{Title: "Roadmap"},
+		column: &api.Column{Title: "Triage"},
+	}
+	assert.Equal(t, "Roadmap › Triage", dest.label())
+
+	dest.projectName = "Website"
+	assert.Equal(t, "Website › Roadmap › Triage", dest.label())
+	assert.Equal(t, "1,2", formatIDs([]int64{1, 2}))
+}
@@ -164,6 +164,10 @@ You can specify the card using either:
 					return fmt.Errorf("failed to fetch comments: %w", err)
 				}
 
+				if err := utils.LoadCommentBoosts(f.Context(), client.Boosts(), resolvedProjectID, comments); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to fetch boosts: %v\n", err)
+				}
+
 				markdown, err := utils.FormatCardAsMarkdown(card, comments)
 				if err != nil {
 					return fmt.Errorf("failed to format card as markdown: %w", err)
@@ -0,0 +1,137 @@
+package card
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+var (
+	wipLineRegex  = regexp.MustCompile(`(?i)^\s*wip(?:\s+limits?)?\s*:\s*(.+)$`)
+	wipLimitRegex = regexp.MustCompile(`^(.+?)\s*[=:]\s*(\d+)$`)
+)
+
+// parseWIPLimits reads limits from a card table description. Any line of
+// the form "WIP limits: Doing=3, Review=2" sets limits for the columns it
+// names; unknown column names are ignored.
+func parseWIPLimits(description string, columns []api.Column) map[int64]int {
+	limits := make(map[int64]int)
+	if description == "" {
+		return limits
+	}
+
+	text, err := markdown.NewConverter().RichTextToMarkdown(description)
+	if err != nil {
+		text = description
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		match := wipLineRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+		for _, item := range strings.FieldsFunc(match[1], func(r rune) bool { return r == ',' || r == ';' }) {
+			pair := wipLimitRegex.FindStringSubmatch(strings.TrimSpace(item))
+			if pair == nil {
+				continue
+			}
+			limit, err := strconv.Atoi(pair[2])
+			if err != nil {
+				continue
+			}
+			for _, column := range columns {
+				if strings.EqualFold(column.Title, strings.TrimSpace(pair[1])) {
+					limits[column.ID] = limit
+				}
+			}
+		}
+	}
+
+	return limits
+}
+
+// loadWIPLimits returns the WIP limits of a card table's columns. Limits set
+// with 'bc4 card column limit' take precedence over the table description.
+func loadWIPLimits(f *factory.Factory, table *api.CardTable) (map[int64]int, error) {
+	limits := parseWIPLimits(table.Description, table.Lists)
+
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := f.AccountID()
+	if err != nil {
+		return nil, err
+	}
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	configured := cfg.Accounts[accountID].ProjectDefaults[projectID].WIPLimits
+	for _, column := range table.Lists {
+		if limit, ok := configured[strconv.FormatInt(column.ID, 10)]; ok {
+			limits[column.ID] = limit
+		}
+	}
+
+	// A limit of zero means no limit
+	for id, limit := range limits {
+		if limit <= 0 {
+			delete(limits, id)
+		}
+	}
+
+	return limits, nil
+}
+
+// wipCount is the number of cards that count toward a column's WIP limit:
+// every card in it except those on hold
+func wipCount(cards []api.Card) int {
+	count := 0
+	for _, card := range cards {
+		if !card.IsOnHold {
+			count++
+		}
+	}
+	return count
+}
+
+// checkWIPLimit refuses a move that would take a column over its WIP limit,
+// or only warns about it when force is set
+func checkWIPLimit(f *factory.Factory, cardOps api.CardOperations, projectID string, table *api.CardTable, columnID int64, force bool) error {
+	limits, err := loadWIPLimits(f, table)
+	if err != nil {
+		return err
+	}
+	limit, ok := limits[columnID]
+	if !ok {
+		return nil
+	}
+
+	for _, column := range table.Lists {
+		if column.ID != columnID {
+			continue
+		}
+		// On-hold cards are listed separately, so these are all active
+		cards, err := cardOps.GetCardsInColumn(f.Context(), projectID, columnID)
+		if err != nil {
+			return fmt.Errorf("failed to get cards in column: %w", err)
+		}
+		count := wipCount(cards)
+		if count < limit {
+			continue
+		}
+		if !force {
+			return fmt.Errorf("column '%s' is at its WIP limit (%d/%d); use --force to move the card anyway", column.Title, count, limit)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: column '%s' is now over its WIP limit (%d/%d)\n", column.Title, count+1, limit)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
 	"github.com/needmore/bc4/internal/ui/tableprinter"
@@ -48,7 +49,7 @@ You can filter answers by date or by person who submitted them.`,
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.date, "date", "", "Filter by date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.date, "date", "", "Filter by date (YYYY-MM-DD or e.g. yesterday)")
 	cmd.Flags().Int64Var(&opts.creatorID, "creator", 0, "Filter by creator person ID")
 
 	return cmd
@@ -81,6 +82,16 @@ func runAnswers(f *factory.Factory, opts *answersOptions, args []string) error {
 		}
 	}
 
+	if opts.date != "" {
+		loc, err := f.Location()
+		if err != nil {
+			return err
+		}
+		if opts.date, err = cmdutil.ResolveDate("Date", opts.date, loc); err != nil {
+			return err
+		}
+	}
+
 	// Build list options
 	listOpts := &api.AnswerListOptions{
 		Date:      opts.date,
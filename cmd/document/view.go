@@ -75,6 +75,10 @@ bc4 document view https://3.basecamp.com/.../documents/12345`,
 					return fmt.Errorf("failed to fetch comments: %w", err)
 				}
 
+				if err := utils.LoadCommentBoosts(f.Context(), client.Boosts(), projectID, comments); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to fetch boosts: %v\n", err)
+				}
+
 				mdContent, err := utils.FormatDocumentAsMarkdown(document, comments)
 				if err != nil {
 					return fmt.Errorf("failed to format document as markdown: %w", err)
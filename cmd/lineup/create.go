@@ -0,0 +1,74 @@
+package lineup
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+type createOptions struct {
+	accountID string
+	name      string
+	date      string
+}
+
+func newCreateCmd(f *factory.Factory) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Add a marker to the Lineup",
+		Long:  `Add a named marker on a date to the account-wide Lineup.`,
+		Example: `  # Mark a launch date
+  bc4 lineup create --name "Product launch" --date 2025-03-01`,
+		Aliases: []string{"add"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreate(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "Marker name (required)")
+	cmd.Flags().StringVarP(&opts.date, "date", "d", "", "Marker date (YYYY-MM-DD or e.g. fri, +2w, end of month; required)")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("date")
+
+	return cmd
+}
+
+func runCreate(f *factory.Factory, opts *createOptions) error {
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+	if opts.date, err = cmdutil.ResolveDate("Date", opts.date, loc); err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	req := api.LineupMarkerRequest{
+		Name: opts.name,
+		Date: opts.date,
+	}
+	if err := client.Lineup().CreateLineupMarker(f.Context(), req); err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Added marker %q on %s\n", opts.name, opts.date)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package lineup
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+type deleteOptions struct {
+	accountID   string
+	skipConfirm bool
+}
+
+func newDeleteCmd(f *factory.Factory) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <marker-id>",
+		Short: "Remove a Lineup marker",
+		Long:  `Remove a marker from the Lineup. This operation cannot be undone.`,
+		Example: `  # Remove a marker (with confirmation prompt)
+  bc4 lineup delete 12345
+
+  # Remove without confirmation
+  bc4 lineup delete 12345 --yes`,
+		Aliases: []string{"rm", "remove"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().BoolVarP(&opts.skipConfirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runDelete(f *factory.Factory, opts *deleteOptions, arg string) error {
+	markerID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid marker ID: %s", arg)
+	}
+
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	if !opts.skipConfirm {
+		var confirm bool
+		if err := huh.NewConfirm().
+			Title(fmt.Sprintf("Delete marker #%d?", markerID)).
+			Affirmative("Delete").
+			Negative("Cancel").
+			Value(&confirm).
+			Run(); err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Canceled")
+			return nil
+		}
+	}
+
+	if err := client.Lineup().DeleteLineupMarker(f.Context(), markerID); err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Deleted marker #%d\n", markerID)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package lineup
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+type editOptions struct {
+	accountID string
+	name      string
+	date      string
+}
+
+func newEditCmd(f *factory.Factory) *cobra.Command {
+	opts := &editOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit <marker-id>",
+		Short: "Rename or move a Lineup marker",
+		Long:  `Change a Lineup marker's name or date. Only the flags you pass are changed.`,
+		Example: `  # Move a marker to a new date
+  bc4 lineup edit 12345 --date 2025-03-15
+
+  # Rename a marker
+  bc4 lineup edit 12345 --name "Public launch"`,
+		Aliases: []string{"update"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEdit(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "New marker name")
+	cmd.Flags().StringVarP(&opts.date, "date", "d", "", "New marker date (YYYY-MM-DD or e.g. fri, +2w)")
+
+	return cmd
+}
+
+func runEdit(f *factory.Factory, opts *editOptions, arg string) error {
+	markerID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid marker ID: %s", arg)
+	}
+
+	if opts.name == "" && opts.date == "" {
+		return fmt.Errorf("nothing to update: pass --name or --date")
+	}
+
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	if opts.date != "" {
+		loc, err := f.Location()
+		if err != nil {
+			return err
+		}
+		if opts.date, err = cmdutil.ResolveDate("Date", opts.date, loc); err != nil {
+			return err
+		}
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	req := api.LineupMarkerRequest{
+		Name: opts.name,
+		Date: opts.date,
+	}
+	if err := client.Lineup().UpdateLineupMarker(f.Context(), markerID, req); err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Updated marker #%d\n", markerID)
+	}
+
+	return nil
+}
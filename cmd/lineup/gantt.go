@@ -0,0 +1,154 @@
+package lineup
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	// Column widths around the timeline: name is capped, dates are fixed,
+	// and the table printer puts 3 spaces between columns
+	maxNameWidth    = 30
+	dateColumnWidth = len(dateLayout)
+	columnSeparator = 3
+	minBarWidth     = 10
+)
+
+// timelineRow is a single project or marker placed on the timeline
+type timelineRow struct {
+	name     string
+	marker   bool
+	start    time.Time
+	end      time.Time
+	state    string
+	markerID int64
+}
+
+// buildTimelineRows collects projects and markers that overlap [from, to],
+// sorted by start date. It also returns how many projects had no dates.
+func buildTimelineRows(projects []api.Project, markers []api.LineupMarker, from, to time.Time) ([]timelineRow, int) {
+	var rows []timelineRow
+	undated := 0
+
+	for _, p := range projects {
+		start, errStart := time.Parse(dateLayout, p.StartDate)
+		end, errEnd := time.Parse(dateLayout, p.EndDate)
+		if errStart != nil || errEnd != nil {
+			undated++
+			continue
+		}
+		if end.Before(from) || start.After(to) {
+			continue
+		}
+		rows = append(rows, timelineRow{name: p.Name, start: start, end: end, state: "active"})
+	}
+
+	for _, m := range markers {
+		date, err := time.Parse(dateLayout, m.Date)
+		if err != nil || date.Before(from) || date.After(to) {
+			continue
+		}
+		rows = append(rows, timelineRow{name: m.Name, marker: true, start: date, end: date, state: "marker", markerID: m.ID})
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if !rows[i].start.Equal(rows[j].start) {
+			return rows[i].start.Before(rows[j].start)
+		}
+		return rows[i].end.Before(rows[j].end)
+	})
+
+	return rows, undated
+}
+
+// timelineWidths splits the table width between the name column and the bar
+func timelineWidths(rows []timelineRow, tableWidth int) (nameWidth, barWidth int) {
+	nameWidth = len("NAME")
+	for _, row := range rows {
+		if w := runewidth.StringWidth(row.name); w > nameWidth {
+			nameWidth = w
+		}
+	}
+	if nameWidth > maxNameWidth {
+		nameWidth = maxNameWidth
+	}
+
+	barWidth = tableWidth - nameWidth - 2*dateColumnWidth - 3*columnSeparator
+	if barWidth < minBarWidth {
+		barWidth = minBarWidth
+	}
+
+	return nameWidth, barWidth
+}
+
+// timelineColumn maps a date to a character cell in a bar of the given width
+func timelineColumn(date, from, to time.Time, width int) int {
+	span := to.Sub(from) + 24*time.Hour
+	col := int(float64(date.Sub(from)) / float64(span) * float64(width))
+	if col < 0 {
+		return 0
+	}
+	if col >= width {
+		return width - 1
+	}
+	return col
+}
+
+// renderTimelineBar draws a row's bar: a filled span for projects and a
+// diamond for markers. Spans cut off by the window get arrow heads, and
+// today's date is shown as a dotted line in empty cells.
+func renderTimelineBar(row timelineRow, from, to, today time.Time, width int) string {
+	cells := make([]rune, width)
+	for i := range cells {
+		cells[i] = ' '
+	}
+
+	if !today.Before(from) && !today.After(to) {
+		cells[timelineColumn(today, from, to, width)] = '┆'
+	}
+
+	startCol := timelineColumn(row.start, from, to, width)
+	if row.marker {
+		cells[startCol] = '◆'
+		return string(cells)
+	}
+
+	endCol := timelineColumn(row.end, from, to, width)
+	for i := startCol; i <= endCol; i++ {
+		cells[i] = '█'
+	}
+	if row.start.Before(from) {
+		cells[startCol] = '◀'
+	}
+	if row.end.After(to) {
+		cells[endCol] = '▶'
+	}
+
+	return string(cells)
+}
+
+// renderTimelineAxis labels both ends of the timeline
+func renderTimelineAxis(from, to time.Time, width int) string {
+	left := from.Format("Jan 2")
+	right := to.Format("Jan 2")
+	gap := width - len(left) - len(right)
+	if gap < 1 {
+		return left
+	}
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// truncateName shortens a name to fit the name column
+func truncateName(name string, width int) string {
+	if runewidth.StringWidth(name) <= width {
+		return name
+	}
+	return runewidth.Truncate(name, width, "...")
+}
@@ -0,0 +1,86 @@
+package lineup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse(dateLayout, s)
+	return t
+}
+
+func TestBuildTimelineRows(t *testing.T) {
+	projects := []api.Project{
+		{Name: "Website", StartDate: "2025-02-01", EndDate: "2025-03-15"},
+		{Name: "Undated"},
+		{Name: "Old", StartDate: "2024-01-01", EndDate: "2024-02-01"},
+		{Name: "Early", StartDate: "2024-12-15", EndDate: "2025-01-10"},
+	}
+	markers := []api.LineupMarker{
+		{ID: 7, Name: "Launch", Date: "2025-03-01"},
+		{ID: 8, Name: "Later", Date: "2025-09-01"},
+	}
+
+	rows, undated := buildTimelineRows(projects, markers, date("2025-01-01"), date("2025-03-31"))
+
+	assert.Equal(t, 1, undated)
+	require.Len(t, rows, 3)
+	assert.Equal(t, "Early", rows[0].name)
+	assert.Equal(t, "Website", rows[1].name)
+	assert.Equal(t, "Launch", rows[2].name)
+	assert.True(t, rows[2].marker)
+	assert.Equal(t, int64(7), rows[2].markerID)
+}
+
+func TestRenderTimelineBar(t *testing.T) {
+	from, to := date("2025-01-01"), date("2025-01-10")
+	today := date("2030-01-01")
+
+	bar := renderTimelineBar(timelineRow{start: date("2025-01-03"), end: date("2025-01-05")}, from, to, today, 10)
+	assert.Equal(t, "  ███     ", bar)
+
+	bar = renderTimelineBar(timelineRow{start: date("2024-12-01"), end: date("2025-02-01")}, from, to, today, 10)
+	assert.Equal(t, "◀████████▶", bar)
+
+	bar = renderTimelineBar(timelineRow{marker: true, start: date("2025-01-06"), end: date("2025-01-06")}, from, to, date("2025-01-02"), 10)
+	assert.Equal(t, " ┆   ◆    ", bar)
+}
+
+func TestTimelineWidths(t *testing.T) {
+	rows := []timelineRow{{name: "A very long project name that will not fit in the column"}}
+
+	nameWidth, barWidth := timelineWidths(rows, 120)
+	assert.Equal(t, maxNameWidth, nameWidth)
+	assert.Equal(t, 120-maxNameWidth-2*dateColumnWidth-3*columnSeparator, barWidth)
+
+	_, barWidth = timelineWidths(rows, 40)
+	assert.Equal(t, minBarWidth, barWidth)
+
+	assert.Equal(t, maxNameWidth, runewidth.StringWidth(truncateName(rows[0].name, maxNameWidth)))
+}
+
+func TestParseWindow(t *testing.T) {
+	today := date("2025-01-15")
+
+	from, to, err := parseWindow("", "", today, time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, today, from)
+	assert.Equal(t, today.AddDate(0, 0, defaultViewDays), to)
+
+	from, to, err = parseWindow("2025-02-01", "2025-03-31", today, time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, date("2025-02-01"), from)
+	assert.Equal(t, date("2025-03-31"), to)
+
+	_, _, err = parseWindow("2025-02-01", "2025-01-01", today, time.UTC)
+	assert.Error(t, err)
+
+	_, _, err = parseWindow("someday", "", today, time.UTC)
+	assert.Error(t, err)
+}
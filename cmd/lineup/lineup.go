@@ -0,0 +1,37 @@
+package lineup
+
+import (
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewLineupCmd creates the lineup command
+func NewLineupCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lineup",
+		Short: "View the Lineup and manage its markers",
+		Long: `Work with the account-wide Lineup.
+
+The Lineup shows every project with dates side by side, along with markers
+for important dates such as launches or holidays. Use 'bc4 lineup view' to
+see it in the terminal.`,
+		Example: `  bc4 lineup view                                  # Next three months
+  bc4 lineup view --from 2025-01-01 --to 2025-06-30
+  bc4 lineup list                                  # List markers
+  bc4 lineup create --name "Launch" --date 2025-03-01`,
+		Aliases: []string{"timeline"},
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(newViewCmd(f))
+	cmd.AddCommand(newListCmd(f))
+	cmd.AddCommand(newCreateCmd(f))
+	cmd.AddCommand(newEditCmd(f))
+	cmd.AddCommand(newDeleteCmd(f))
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package lineup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type listOptions struct {
+	accountID  string
+	jsonOutput bool
+}
+
+func newListCmd(f *factory.Factory) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List Lineup markers",
+		Long:  `List all markers on the Lineup, ordered by date.`,
+		Example: `  # List markers
+  bc4 lineup list
+
+  # Output as JSON
+  bc4 lineup list --json`,
+		Aliases: []string{"ls", "markers"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runList(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+
+	return cmd
+}
+
+func runList(f *factory.Factory, opts *listOptions) error {
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	markers, err := client.Lineup().ListLineupMarkers(f.Context())
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(markers, func(i, j int) bool {
+		return markers[i].Date < markers[j].Date
+	})
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(markers)
+	}
+
+	if len(markers) == 0 {
+		fmt.Println("No markers found.")
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+	table.AddHeader("ID", "DATE", "NAME")
+
+	cs := table.GetColorScheme()
+	for _, m := range markers {
+		table.AddIDField(strconv.FormatInt(m.ID, 10), "active")
+		table.AddField(m.Date, cs.Muted)
+		table.AddField(m.Name, cs.Bold)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -0,0 +1,205 @@
+package lineup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// defaultViewDays is how far ahead the Lineup is shown when --to is omitted
+const defaultViewDays = 90
+
+type viewOptions struct {
+	accountID  string
+	from       string
+	to         string
+	jsonOutput bool
+}
+
+// timelineEntry is the JSON form of a timeline row
+type timelineEntry struct {
+	Type  string `json:"type"`
+	ID    int64  `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+func newViewCmd(f *factory.Factory) *cobra.Command {
+	opts := &viewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Show the Lineup as a timeline",
+		Long: `Show projects with dates and Lineup markers as a timeline in the terminal.
+
+Each project is drawn as a bar from its start date to its end date, and each
+marker as a diamond. Bars that extend past the window end in arrows, and
+today is shown as a dotted line. The timeline stretches to fit the terminal.
+
+Projects without start and end dates are not shown.`,
+		Example: `  # The next three months
+  bc4 lineup view
+
+  # A specific window
+  bc4 lineup view --from 2025-01-01 --to 2025-06-30
+
+  # Output as JSON
+  bc4 lineup view --json`,
+		Aliases: []string{"show"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runView(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVar(&opts.from, "from", "", "Start of the window (YYYY-MM-DD or e.g. mon, +2w; default today)")
+	cmd.Flags().StringVar(&opts.to, "to", "", fmt.Sprintf("End of the window (YYYY-MM-DD or e.g. end of month; default %d days after --from)", defaultViewDays))
+
+	return cmd
+}
+
+// parseWindow resolves the --from and --to flags into a date range. Relative
+// dates are resolved in loc; the range itself is kept as calendar dates.
+func parseWindow(fromStr, toStr string, today time.Time, loc *time.Location) (time.Time, time.Time, error) {
+	from := today
+	if fromStr != "" {
+		resolved, err := cmdutil.ResolveDate("From", fromStr, loc)
+		if err != nil {
+			return from, from, err
+		}
+		from, _ = time.Parse(dateLayout, resolved)
+	}
+
+	to := from.AddDate(0, 0, defaultViewDays)
+	if toStr != "" {
+		resolved, err := cmdutil.ResolveDate("To", toStr, loc)
+		if err != nil {
+			return from, to, err
+		}
+		to, _ = time.Parse(dateLayout, resolved)
+	}
+
+	if to.Before(from) {
+		return from, to, fmt.Errorf("--to must not be before --from")
+	}
+
+	return from, to, nil
+}
+
+func runView(f *factory.Factory, opts *viewOptions) error {
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+	today, _ := time.Parse(dateLayout, time.Now().In(loc).Format(dateLayout))
+	from, to, err := parseWindow(opts.from, opts.to, today, loc)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	projects, err := client.Projects().GetProjects(f.Context())
+	if err != nil {
+		return err
+	}
+
+	// Markers are a nice-to-have here; still show projects if they can't be loaded
+	markers, err := client.Lineup().ListLineupMarkers(f.Context())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	rows, undated := buildTimelineRows(projects, markers, from, to)
+
+	if opts.jsonOutput {
+		entries := make([]timelineEntry, len(rows))
+		for i, row := range rows {
+			entries[i] = timelineEntry{
+				Type:  "project",
+				Name:  row.name,
+				Start: row.start.Format(dateLayout),
+				End:   row.end.Format(dateLayout),
+			}
+			if row.marker {
+				entries[i].Type = "marker"
+				entries[i].ID = row.markerID
+			}
+		}
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	}
+
+	if len(rows) == 0 {
+		fmt.Printf("Nothing on the Lineup between %s and %s.\n", from.Format(dateLayout), to.Format(dateLayout))
+		return nil
+	}
+
+	if err := renderTimeline(rows, from, to, today); err != nil {
+		return err
+	}
+
+	if undated > 0 && ui.IsTerminal(os.Stdout) {
+		fmt.Printf("\n%d project(s) without dates not shown.\n", undated)
+	}
+
+	return nil
+}
+
+// renderTimeline prints the rows as a table whose last column is the timeline
+// bar, sized to whatever width the table printer has left over
+func renderTimeline(rows []timelineRow, from, to, today time.Time) error {
+	table := tableprinter.New(os.Stdout)
+	cs := table.GetColorScheme()
+
+	if !table.IsTTY() {
+		table.AddHeader("TYPE", "NAME", "START", "END")
+		for _, row := range rows {
+			kind := "project"
+			if row.marker {
+				kind = "marker"
+			}
+			table.AddField(kind)
+			table.AddField(row.name)
+			table.AddField(row.start.Format(dateLayout))
+			table.AddField(row.end.Format(dateLayout))
+			table.EndRow()
+		}
+		return table.Render()
+	}
+
+	nameWidth, barWidth := timelineWidths(rows, table.MaxWidth())
+
+	table.AddHeader("NAME", "START", "END", renderTimelineAxis(from, to, barWidth))
+	for _, row := range rows {
+		barColor := cs.Green
+		if row.marker {
+			barColor = cs.Magenta
+		}
+
+		table.AddField(truncateName(row.name, nameWidth), cs.Bold)
+		table.AddField(row.start.Format(dateLayout), cs.Muted)
+		table.AddField(row.end.Format(dateLayout), cs.Muted)
+		table.AddField(renderTimelineBar(row, from, to, today, barWidth), barColor)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +20,7 @@ func newPostCmd(f *factory.Factory) *cobra.Command {
 		title      string
 		content    string
 		categoryID int64
+		notify     []string
 	)
 
 	cmd := &cobra.Command{
@@ -30,7 +32,10 @@ You can provide message content in several ways:
   - Interactively (default)
   - Via --content flag
   - Via stdin: echo "content" | bc4 message post [project] --title "Title"
-  - From file: cat message.md | bc4 message post [project] --title "Title"`,
+  - From file: cat message.md | bc4 message post [project] --title "Title"
+
+By default everyone on the project is notified. Use --notify to only notify
+specific people (by email or @mention).`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply project override if specified
@@ -118,6 +123,14 @@ You can provide message content in several ways:
 				req.CategoryID = &categoryID
 			}
 
+			if len(notify) > 0 {
+				userResolver := utils.NewUserResolver(client.Client, projectID)
+				req.Subscriptions, err = userResolver.ResolveUsers(f.Context(), notify)
+				if err != nil {
+					return fmt.Errorf("failed to resolve people to notify: %w", err)
+				}
+			}
+
 			message, err := client.CreateMessage(f.Context(), projectID, board.ID, req)
 			if err != nil {
 				return err
@@ -137,6 +150,7 @@ You can provide message content in several ways:
 	cmd.Flags().StringVarP(&title, "title", "t", "", "Message subject")
 	cmd.Flags().StringVarP(&content, "content", "c", "", "Message content (markdown supported)")
 	cmd.Flags().Int64Var(&categoryID, "category-id", 0, "Category ID")
+	cmd.Flags().StringSliceVar(&notify, "notify", nil, "Only notify these people (by email or @mention)")
 
 	return cmd
 }
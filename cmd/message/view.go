@@ -71,6 +71,14 @@ bc4 message view https://3.basecamp.com/.../messages/12345`,
 				return err
 			}
 
+			// Load boosts so reactions show up alongside the message
+			if message.BoostsCount > 0 {
+				// Boosts are decoration; show the message without them if they fail
+				if message.Boosts, err = client.Boosts().ListBoosts(f.Context(), projectID, message.ID); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to fetch boosts: %v\n", err)
+				}
+			}
+
 			// Handle output with comments
 			if withComments {
 				comments, err := client.ListComments(f.Context(), projectID, message.ID)
@@ -78,6 +86,10 @@ bc4 message view https://3.basecamp.com/.../messages/12345`,
 					return fmt.Errorf("failed to fetch comments: %w", err)
 				}
 
+				if err := utils.LoadCommentBoosts(f.Context(), client.Boosts(), projectID, comments); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to fetch boosts: %v\n", err)
+				}
+
 				markdown, err := utils.FormatMessageAsMarkdown(message, comments)
 				if err != nil {
 					return fmt.Errorf("failed to format message as markdown: %w", err)
@@ -140,6 +152,10 @@ bc4 message view https://3.basecamp.com/.../messages/12345`,
 				fmt.Fprintf(&buf, "%s\n", metaStyle.Render(fmt.Sprintf("%d comments", message.CommentsCount)))
 			}
 
+			if summary := utils.FormatBoostSummary(message.Boosts); summary != "" {
+				fmt.Fprintf(&buf, "%s\n", metaStyle.Render("Boosts: "+summary))
+			}
+
 			fmt.Fprintln(&buf)
 
 			// Render content with glamour
@@ -0,0 +1,27 @@
+package my
+
+import (
+	"github.com/needmore/bc4/cmd/todo"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewMyCmd creates the my command
+func NewMyCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "my",
+		Short: "Work assigned to you across all projects",
+		Long:  `Shortcuts for the things on your plate across every project in the account.`,
+		Example: `  bc4 my work                      # Open todos and card steps, grouped by due date
+  bc4 my work --person "Jane Smith" # Someone else's assignments`,
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(todo.NewMyWorkCmd(f))
+
+	return cmd
+}
@@ -0,0 +1,143 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui"
+)
+
+// constructionPollInterval is how often the construction status is checked
+var constructionPollInterval = 2 * time.Second
+
+type constructionPolledMsg struct {
+	construction *api.ProjectConstruction
+	err          error
+}
+
+type constructionTickMsg struct{}
+
+// constructionWaitModel shows a spinner while a project is built from a template
+type constructionWaitModel struct {
+	ctx          context.Context
+	templateOps  api.TemplateOperations
+	templateID   int64
+	templateName string
+	construction *api.ProjectConstruction
+	deadline     time.Time
+	spinner      spinner.Model
+	err          error
+}
+
+func (m constructionWaitModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.poll())
+}
+
+func (m constructionWaitModel) poll() tea.Cmd {
+	return func() tea.Msg {
+		construction, err := m.templateOps.GetProjectConstruction(m.ctx, m.templateID, m.construction.ID)
+		return constructionPolledMsg{construction: construction, err: err}
+	}
+}
+
+func (m constructionWaitModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" {
+			m.err = fmt.Errorf("stopped waiting; the project is still being created")
+			return m, tea.Quit
+		}
+
+	case constructionPolledMsg:
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+		m.construction = msg.construction
+		if constructionFinished(m.construction) {
+			return m, tea.Quit
+		}
+		if time.Now().After(m.deadline) {
+			m.err = fmt.Errorf("timed out waiting for project construction #%d", m.construction.ID)
+			return m, tea.Quit
+		}
+		return m, tea.Tick(constructionPollInterval, func(time.Time) tea.Msg {
+			return constructionTickMsg{}
+		})
+
+	case constructionTickMsg:
+		return m, m.poll()
+
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+func (m constructionWaitModel) View() string {
+	if m.err != nil || constructionFinished(m.construction) {
+		return ""
+	}
+	return fmt.Sprintf("%s Creating project from template %s (%s)...\n", m.spinner.View(), m.templateName, m.construction.Status)
+}
+
+// constructionFinished reports whether a construction has stopped running
+func constructionFinished(c *api.ProjectConstruction) bool {
+	return c.Status == api.ConstructionStatusCompleted || c.Status == api.ConstructionStatusFailed
+}
+
+// waitForConstruction polls a project construction until it finishes or the
+// timeout passes, showing a spinner when attached to a terminal
+func waitForConstruction(ctx context.Context, templateOps api.TemplateOperations, template *api.Template, construction *api.ProjectConstruction, timeout time.Duration) (*api.ProjectConstruction, error) {
+	if constructionFinished(construction) {
+		return construction, nil
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	if ui.IsTerminal(os.Stdout) {
+		s := spinner.New()
+		s.Spinner = spinner.Dot
+		s.Style = ui.SelectedItemStyle
+
+		m := constructionWaitModel{
+			ctx:          ctx,
+			templateOps:  templateOps,
+			templateID:   template.ID,
+			templateName: template.Name,
+			construction: construction,
+			deadline:     deadline,
+			spinner:      s,
+		}
+
+		finalModel, err := tea.NewProgram(m).Run()
+		if err != nil {
+			return nil, err
+		}
+		final := finalModel.(constructionWaitModel)
+		return final.construction, final.err
+	}
+
+	for {
+		current, err := templateOps.GetProjectConstruction(ctx, template.ID, construction.ID)
+		if err != nil {
+			return nil, err
+		}
+		if constructionFinished(current) {
+			return current, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for project construction #%d", construction.ID)
+		}
+		time.Sleep(constructionPollInterval)
+	}
+}
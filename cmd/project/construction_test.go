@@ -0,0 +1,54 @@
+package project
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeConstructionOps struct {
+	api.TemplateOperations
+	statuses []string
+	polls    int
+}
+
+func (f *fakeConstructionOps) GetProjectConstruction(ctx context.Context, templateID, constructionID int64) (*api.ProjectConstruction, error) {
+	status := f.statuses[f.polls]
+	f.polls++
+
+	construction := &api.ProjectConstruction{ID: constructionID, Status: status}
+	if status == api.ConstructionStatusCompleted {
+		construction.Project = &api.Project{ID: 99, Name: "Acme"}
+	}
+	return construction, nil
+}
+
+func TestWaitForConstruction(t *testing.T) {
+	orig := constructionPollInterval
+	constructionPollInterval = time.Millisecond
+	defer func() { constructionPollInterval = orig }()
+
+	ops := &fakeConstructionOps{statuses: []string{"pending", "pending", "completed"}}
+	start := &api.ProjectConstruction{ID: 5, Status: api.ConstructionStatusPending}
+
+	result, err := waitForConstruction(context.Background(), ops, &api.Template{ID: 1}, start, time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 3, ops.polls)
+	assert.Equal(t, int64(99), result.Project.ID)
+}
+
+func TestWaitForConstructionTimeout(t *testing.T) {
+	orig := constructionPollInterval
+	constructionPollInterval = time.Millisecond
+	defer func() { constructionPollInterval = orig }()
+
+	ops := &fakeConstructionOps{statuses: []string{"pending", "pending", "pending"}}
+	start := &api.ProjectConstruction{ID: 5, Status: api.ConstructionStatusPending}
+
+	_, err := waitForConstruction(context.Background(), ops, &api.Template{ID: 1}, start, 0)
+	assert.ErrorContains(t, err, "timed out")
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/cmd/template"
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/ui"
@@ -17,6 +19,9 @@ func newCreateCmd(f *factory.Factory) *cobra.Command {
 	var description string
 	var accountID string
 	var jsonOutput bool
+	var templateArg string
+	var wait bool
+	var timeout time.Duration
 
 	cmd := &cobra.Command{
 		Use:   "create",
@@ -25,11 +30,20 @@ func newCreateCmd(f *factory.Factory) *cobra.Command {
 
 You can specify the project name and description via flags, or use interactive mode.
 
+Use --template to build the project from a template (see 'bc4 template list').
+Basecamp creates projects from templates in the background; add --wait to
+block until the project is ready and print its URL.
+
 Examples:
   bc4 project create                              # Interactive mode
   bc4 project create --name "My Project"          # Create with name only
-  bc4 project create --name "My Project" --description "Project description"`,
+  bc4 project create --name "My Project" --description "Project description"
+  bc4 project create --name "Acme" --template "Client onboarding" --wait`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if wait && templateArg == "" {
+				return fmt.Errorf("--wait requires --template")
+			}
+
 			// Apply overrides if specified
 			if accountID != "" {
 				f = f.WithAccount(accountID)
@@ -72,6 +86,10 @@ Examples:
 				Description: description,
 			}
 
+			if templateArg != "" {
+				return createFromTemplate(f, client, templateArg, req, wait, timeout, jsonOutput)
+			}
+
 			project, err := client.Projects().CreateProject(f.Context(), req)
 			if err != nil {
 				return fmt.Errorf("failed to create project: %w", err)
@@ -98,6 +116,66 @@ Examples:
 	cmd.Flags().StringVarP(&description, "description", "d", "", "Project description")
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	cmd.Flags().StringVarP(&templateArg, "template", "t", "", "Create the project from a template (name, ID, or URL)")
+	cmd.Flags().BoolVarP(&wait, "wait", "w", false, "Wait until a templated project is ready (requires --template)")
+	cmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "Maximum time to wait with --wait")
 
 	return cmd
 }
+
+// createFromTemplate starts a project construction from a template and,
+// when requested, waits for it to finish
+func createFromTemplate(f *factory.Factory, client *api.ModularClient, templateArg string, req api.ProjectCreateRequest, wait bool, timeout time.Duration, jsonOutput bool) error {
+	templateOps := client.Templates()
+
+	tmpl, err := template.ResolveTemplate(f.Context(), templateOps, templateArg)
+	if err != nil {
+		return err
+	}
+
+	construction, err := templateOps.CreateProjectConstruction(f.Context(), tmpl.ID, req)
+	if err != nil {
+		return err
+	}
+
+	if wait {
+		construction, err = waitForConstruction(f.Context(), templateOps, tmpl, construction, timeout)
+		if err != nil {
+			return err
+		}
+		if construction.Status == api.ConstructionStatusFailed {
+			return fmt.Errorf("failed to create project from template %s", tmpl.Name)
+		}
+	}
+
+	// Output
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(construction)
+	}
+
+	project := construction.Project
+	if construction.Status != api.ConstructionStatusCompleted || project == nil {
+		if ui.IsTerminal(os.Stdout) {
+			fmt.Printf("✓ Started creating project %q from template: %s (construction #%d, %s)\n", req.Name, tmpl.Name, construction.ID, construction.Status)
+			fmt.Println("  Use --wait to block until the project is ready.")
+		} else {
+			fmt.Printf("%d\n", construction.ID)
+		}
+		return nil
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Created project: %s (#%d) from template: %s\n", project.Name, project.ID, tmpl.Name)
+		if project.AppURL != "" {
+			fmt.Printf("  %s\n", project.AppURL)
+		}
+	} else if project.AppURL != "" {
+		fmt.Println(project.AppURL)
+	} else {
+		fmt.Printf("%d\n", project.ID)
+	}
+
+	return nil
+}
@@ -11,18 +11,24 @@ import (
 	"github.com/needmore/bc4/cmd/account"
 	"github.com/needmore/bc4/cmd/activity"
 	"github.com/needmore/bc4/cmd/auth"
+	"github.com/needmore/bc4/cmd/boost"
 	"github.com/needmore/bc4/cmd/campfire"
 	"github.com/needmore/bc4/cmd/card"
 	"github.com/needmore/bc4/cmd/checkin"
 	"github.com/needmore/bc4/cmd/comment"
 	"github.com/needmore/bc4/cmd/document"
+	"github.com/needmore/bc4/cmd/lineup"
 	"github.com/needmore/bc4/cmd/message"
+	"github.com/needmore/bc4/cmd/my"
 	"github.com/needmore/bc4/cmd/people"
 	"github.com/needmore/bc4/cmd/profile"
 	"github.com/needmore/bc4/cmd/project"
 	"github.com/needmore/bc4/cmd/schedule"
 	"github.com/needmore/bc4/cmd/search"
+	"github.com/needmore/bc4/cmd/subscription"
+	"github.com/needmore/bc4/cmd/template"
 	"github.com/needmore/bc4/cmd/todo"
+	"github.com/needmore/bc4/cmd/webhook"
 	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/config"
 	"github.com/needmore/bc4/internal/errors"
@@ -114,6 +120,9 @@ func Execute() {
 		case errors.IsNotFoundError(unwrappedErr):
 			exitCode = cmdutil.ExitNotFound
 		}
+		if code, ok := cmdutil.ExitCodeOf(err); ok {
+			exitCode = code
+		}
 
 		// Only format and display error if it's not a silent error
 		// (silent errors have already been displayed by the command)
@@ -160,6 +169,7 @@ func init() {
 	rootCmd.AddCommand(activity.NewActivityCmd(f))
 	rootCmd.AddCommand(project.NewProjectCmd(f))
 	rootCmd.AddCommand(todo.NewTodoCmd(f))
+	rootCmd.AddCommand(my.NewMyCmd(f))
 	rootCmd.AddCommand(message.NewMessageCmd(f))
 	rootCmd.AddCommand(document.NewDocumentCmd(f))
 	rootCmd.AddCommand(campfire.NewCampfireCmd(f))
@@ -170,6 +180,13 @@ func init() {
 	rootCmd.AddCommand(profile.NewProfileCmd(f))
 	rootCmd.AddCommand(schedule.NewScheduleCmd(f))
 	rootCmd.AddCommand(search.NewSearchCmd(f))
+	rootCmd.AddCommand(webhook.NewWebhookCmd(f))
+	rootCmd.AddCommand(subscription.NewSubscribeCmd(f))
+	rootCmd.AddCommand(subscription.NewUnsubscribeCmd(f))
+	rootCmd.AddCommand(subscription.NewSubscribersCmd(f))
+	rootCmd.AddCommand(boost.NewBoostCmd(f))
+	rootCmd.AddCommand(template.NewTemplateCmd(f))
+	rootCmd.AddCommand(lineup.NewLineupCmd(f))
 
 	// Add version command (doesn't need factory)
 	rootCmd.AddCommand(versionCmd)
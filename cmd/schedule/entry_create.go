@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
@@ -36,7 +37,8 @@ Events can be:
 Date/time formats:
 - Date only: 2025-01-15 (assumes all-day or start of day)
 - With time: 2025-01-15T14:30:00
-- Relative: today, tomorrow, next-monday`,
+- Relative: today, tomorrow, fri, next monday, +3d, end of month
+  (resolved in the configured timezone; the date is echoed to stderr)`,
 		Example: `  # Create a simple event (prompts for time)
   bc4 schedule entry create "Team Meeting"
 
@@ -61,8 +63,8 @@ Date/time formats:
 	}
 
 	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Event description")
-	cmd.Flags().StringVar(&opts.startsAt, "starts-at", "", "Start date/time (YYYY-MM-DD or YYYY-MM-DDTHH:MM:SS)")
-	cmd.Flags().StringVar(&opts.endsAt, "ends-at", "", "End date/time (YYYY-MM-DD or YYYY-MM-DDTHH:MM:SS)")
+	cmd.Flags().StringVar(&opts.startsAt, "starts-at", "", "Start date/time (YYYY-MM-DD, YYYY-MM-DDTHH:MM:SS, or e.g. tomorrow)")
+	cmd.Flags().StringVar(&opts.endsAt, "ends-at", "", "End date/time (YYYY-MM-DD, YYYY-MM-DDTHH:MM:SS, or e.g. tomorrow)")
 	cmd.Flags().BoolVar(&opts.allDay, "all-day", false, "Create an all-day event")
 	cmd.Flags().StringSliceVar(&opts.participants, "participant", nil, "Add participant (email or name, can be used multiple times)")
 	cmd.Flags().BoolVar(&opts.notify, "notify", false, "Notify participants about the event")
@@ -95,14 +97,18 @@ func runEntryCreate(f *factory.Factory, opts *entryCreateOptions, args []string)
 	}
 
 	// Parse and validate dates
-	startsAt, err := parseDateTime(opts.startsAt, opts.allDay)
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+	startsAt, err := parseDateTime("Start", opts.startsAt, opts.allDay, loc)
 	if err != nil {
 		return fmt.Errorf("invalid start date/time: %w", err)
 	}
 
 	var endsAt string
 	if opts.endsAt != "" {
-		endsAt, err = parseDateTime(opts.endsAt, opts.allDay)
+		endsAt, err = parseDateTime("End", opts.endsAt, opts.allDay, loc)
 		if err != nil {
 			return fmt.Errorf("invalid end date/time: %w", err)
 		}
@@ -158,36 +164,13 @@ func runEntryCreate(f *factory.Factory, opts *entryCreateOptions, args []string)
 	return nil
 }
 
-// parseDateTime parses various date/time formats and returns RFC3339 format
-func parseDateTime(input string, allDay bool) (string, error) {
+// parseDateTime parses a date/time flag and returns RFC3339 format, or a
+// plain date for all-day events. Dates without a time, including relative
+// ones such as "tomorrow" or "next monday", resolve in loc and default to
+// 9 AM for timed events.
+func parseDateTime(label, input string, allDay bool, loc *time.Location) (string, error) {
 	input = strings.TrimSpace(input)
 
-	// Handle relative dates
-	now := time.Now()
-	switch strings.ToLower(input) {
-	case "today":
-		if allDay {
-			return now.Format("2006-01-02"), nil
-		}
-		return time.Date(now.Year(), now.Month(), now.Day(), 9, 0, 0, 0, now.Location()).Format(time.RFC3339), nil
-	case "tomorrow":
-		tomorrow := now.AddDate(0, 0, 1)
-		if allDay {
-			return tomorrow.Format("2006-01-02"), nil
-		}
-		return time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 9, 0, 0, 0, now.Location()).Format(time.RFC3339), nil
-	case "next-monday":
-		daysUntilMonday := (8 - int(now.Weekday())) % 7
-		if daysUntilMonday == 0 {
-			daysUntilMonday = 7
-		}
-		monday := now.AddDate(0, 0, daysUntilMonday)
-		if allDay {
-			return monday.Format("2006-01-02"), nil
-		}
-		return time.Date(monday.Year(), monday.Month(), monday.Day(), 9, 0, 0, 0, now.Location()).Format(time.RFC3339), nil
-	}
-
 	// Try RFC3339 format first
 	if t, err := time.Parse(time.RFC3339, input); err == nil {
 		if allDay {
@@ -196,30 +179,26 @@ func parseDateTime(input string, allDay bool) (string, error) {
 		return t.Format(time.RFC3339), nil
 	}
 
-	// Try ISO 8601 with seconds
-	if t, err := time.Parse("2006-01-02T15:04:05", input); err == nil {
-		if allDay {
-			return t.Format("2006-01-02"), nil
+	// Try ISO 8601 with and without seconds
+	for _, layout := range []string{"2006-01-02T15:04:05", "2006-01-02T15:04"} {
+		if t, err := time.ParseInLocation(layout, input, loc); err == nil {
+			if allDay {
+				return t.Format("2006-01-02"), nil
+			}
+			return t.Format(time.RFC3339), nil
 		}
-		return t.Format(time.RFC3339), nil
 	}
 
-	// Try ISO 8601 without seconds
-	if t, err := time.Parse("2006-01-02T15:04", input); err == nil {
-		if allDay {
-			return t.Format("2006-01-02"), nil
-		}
-		return t.Format(time.RFC3339), nil
+	// Dates, absolute or relative
+	date, err := cmdutil.ResolveDate(label, input, loc)
+	if err != nil {
+		return "", fmt.Errorf("unrecognized date/time format: %s (use YYYY-MM-DD, YYYY-MM-DDTHH:MM:SS, or e.g. tomorrow, next monday)", input)
 	}
-
-	// Try date-only format
-	if t, err := time.Parse("2006-01-02", input); err == nil {
-		if allDay {
-			return t.Format("2006-01-02"), nil
-		}
-		// Default to 9 AM for date-only input on timed events
-		return time.Date(t.Year(), t.Month(), t.Day(), 9, 0, 0, 0, time.Local).Format(time.RFC3339), nil
+	if allDay {
+		return date, nil
 	}
 
-	return "", fmt.Errorf("unrecognized date/time format: %s (use YYYY-MM-DD or YYYY-MM-DDTHH:MM:SS)", input)
+	// Default to 9 AM for date-only input on timed events
+	t, _ := time.ParseInLocation("2006-01-02", date, loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 9, 0, 0, 0, loc).Format(time.RFC3339), nil
 }
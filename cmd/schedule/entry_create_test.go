@@ -169,7 +169,7 @@ func TestParseDateTime(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := parseDateTime(tt.input, tt.allDay)
+			result, err := parseDateTime("Start", tt.input, tt.allDay, time.Local)
 
 			if tt.expectError {
 				assert.Error(t, err)
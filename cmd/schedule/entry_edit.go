@@ -72,8 +72,8 @@ You can specify the entry using either:
 
 	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Update event title")
 	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Update event description")
-	cmd.Flags().StringVar(&opts.startsAt, "starts-at", "", "Update start date/time (YYYY-MM-DD or YYYY-MM-DDTHH:MM:SS)")
-	cmd.Flags().StringVar(&opts.endsAt, "ends-at", "", "Update end date/time (YYYY-MM-DD or YYYY-MM-DDTHH:MM:SS)")
+	cmd.Flags().StringVar(&opts.startsAt, "starts-at", "", "Update start date/time (YYYY-MM-DD, YYYY-MM-DDTHH:MM:SS, or e.g. tomorrow)")
+	cmd.Flags().StringVar(&opts.endsAt, "ends-at", "", "Update end date/time (YYYY-MM-DD, YYYY-MM-DDTHH:MM:SS, or e.g. tomorrow)")
 	cmd.Flags().BoolVar(&allDayFlag, "all-day", false, "Change to all-day event")
 	cmd.Flags().BoolVar(&noAllDayFlag, "no-all-day", false, "Change to timed event")
 	cmd.Flags().StringSliceVar(&opts.participants, "participant", nil, "Set participant (email or name, can be used multiple times)")
@@ -129,8 +129,13 @@ func runEntryEdit(f *factory.Factory, opts *entryEditOptions, args []string) err
 	// Determine if this is going to be an all-day event for date parsing
 	isAllDay := opts.allDay != nil && *opts.allDay
 
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+
 	if opts.startsAt != "" {
-		startsAt, err := parseDateTime(opts.startsAt, isAllDay)
+		startsAt, err := parseDateTime("Start", opts.startsAt, isAllDay, loc)
 		if err != nil {
 			return fmt.Errorf("invalid start date/time: %w", err)
 		}
@@ -139,7 +144,7 @@ func runEntryEdit(f *factory.Factory, opts *entryEditOptions, args []string) err
 	}
 
 	if opts.endsAt != "" {
-		endsAt, err := parseDateTime(opts.endsAt, isAllDay)
+		endsAt, err := parseDateTime("End", opts.endsAt, isAllDay, loc)
 		if err != nil {
 			return fmt.Errorf("invalid end date/time: %w", err)
 		}
@@ -0,0 +1,38 @@
+package subscription
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+)
+
+// renderSubscription prints the subscribers of a recording as JSON or a table
+func renderSubscription(subscription *api.Subscription, jsonOutput bool) error {
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(subscription)
+	}
+
+	if len(subscription.Subscribers) == 0 {
+		fmt.Println("No subscribers.")
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+	table.AddHeader("ID", "NAME", "EMAIL")
+
+	cs := table.GetColorScheme()
+	for _, person := range subscription.Subscribers {
+		table.AddIDField(strconv.FormatInt(person.ID, 10), "active")
+		table.AddField(person.Name, cs.Bold)
+		table.AddField(person.EmailAddress)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
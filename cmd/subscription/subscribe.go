@@ -0,0 +1,83 @@
+package subscription
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type subscribeOptions struct {
+	accountID  string
+	projectID  string
+	jsonOutput bool
+}
+
+// NewSubscribeCmd creates the subscribe command
+func NewSubscribeCmd(f *factory.Factory) *cobra.Command {
+	opts := &subscribeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "subscribe <recording-id|url>",
+		Short: "Subscribe yourself to a recording",
+		Long: `Subscribe yourself to a Basecamp recording (todo, message, document, card,
+and so on) so you are notified about new comments and changes.
+
+Use 'bc4 subscribers' to manage subscriptions for other people.`,
+		Example: `  # Subscribe to a todo by URL
+  bc4 subscribe https://3.basecamp.com/1234567/buckets/89012345/todos/111
+
+  # Subscribe to a recording in the current project by ID
+  bc4 subscribe 111`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runSubscribe(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+func runSubscribe(f *factory.Factory, opts *subscribeOptions, arg string) error {
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, recordingID, err := cmdutil.ResolveRecordingArg(f, arg)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	subscription, err := client.Subscriptions().Subscribe(f.Context(), projectID, recordingID)
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		return renderSubscription(subscription, true)
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Subscribed to #%d (%d subscriber(s))\n", recordingID, subscription.Count)
+	} else {
+		fmt.Println(recordingID)
+	}
+
+	return nil
+}
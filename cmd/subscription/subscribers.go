@@ -0,0 +1,114 @@
+package subscription
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type subscribersOptions struct {
+	accountID  string
+	projectID  string
+	add        []string
+	remove     []string
+	jsonOutput bool
+}
+
+// NewSubscribersCmd creates the subscribers command
+func NewSubscribersCmd(f *factory.Factory) *cobra.Command {
+	opts := &subscribersOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "subscribers <recording-id|url>",
+		Short: "List or change who is subscribed to a recording",
+		Long: `List the people subscribed to a Basecamp recording, or add and remove
+subscribers with --add and --remove.
+
+People can be given by email address, @mention, or name, the same way
+as 'bc4 todo add --assign'.`,
+		Example: `  # List subscribers of a card
+  bc4 subscribers https://3.basecamp.com/1234567/buckets/89012345/card_tables/cards/333
+
+  # Add two people and remove one
+  bc4 subscribers 333 --add @jane,bob@example.com --remove @alex
+
+  # Output as JSON
+  bc4 subscribers 333 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runSubscribers(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringSliceVar(&opts.add, "add", nil, "Subscribe people (by email, @mention, or name)")
+	cmd.Flags().StringSliceVar(&opts.remove, "remove", nil, "Unsubscribe people (by email, @mention, or name)")
+
+	return cmd
+}
+
+func runSubscribers(f *factory.Factory, opts *subscribersOptions, arg string) error {
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, recordingID, err := cmdutil.ResolveRecordingArg(f, arg)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	subscriptionOps := client.Subscriptions()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	// Without changes, just show the current subscribers
+	if len(opts.add) == 0 && len(opts.remove) == 0 {
+		subscription, err := subscriptionOps.GetSubscription(f.Context(), projectID, recordingID)
+		if err != nil {
+			return err
+		}
+		return renderSubscription(subscription, opts.jsonOutput)
+	}
+
+	userResolver := utils.NewUserResolver(client.Client, projectID)
+
+	var req api.SubscriptionUpdateRequest
+	if len(opts.add) > 0 {
+		req.Subscriptions, err = userResolver.ResolveUsers(f.Context(), opts.add)
+		if err != nil {
+			return fmt.Errorf("failed to resolve people to add: %w", err)
+		}
+	}
+	if len(opts.remove) > 0 {
+		req.Unsubscriptions, err = userResolver.ResolveUsers(f.Context(), opts.remove)
+		if err != nil {
+			return fmt.Errorf("failed to resolve people to remove: %w", err)
+		}
+	}
+
+	subscription, err := subscriptionOps.UpdateSubscription(f.Context(), projectID, recordingID, req)
+	if err != nil {
+		return err
+	}
+
+	if !opts.jsonOutput && ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Added %d and removed %d subscriber(s) on #%d\n\n",
+			len(req.Subscriptions), len(req.Unsubscriptions), recordingID)
+	}
+
+	return renderSubscription(subscription, opts.jsonOutput)
+}
@@ -0,0 +1,19 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandFlags(t *testing.T) {
+	f := factory.New()
+
+	cmd := NewSubscribersCmd(f)
+	assert.NotNil(t, cmd.Flags().Lookup("add"))
+	assert.NotNil(t, cmd.Flags().Lookup("remove"))
+
+	assert.Equal(t, "subscribe <recording-id|url>", NewSubscribeCmd(f).Use)
+	assert.Equal(t, "unsubscribe <recording-id|url>", NewUnsubscribeCmd(f).Use)
+}
@@ -0,0 +1,74 @@
+package subscription
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+type unsubscribeOptions struct {
+	accountID string
+	projectID string
+}
+
+// NewUnsubscribeCmd creates the unsubscribe command
+func NewUnsubscribeCmd(f *factory.Factory) *cobra.Command {
+	opts := &unsubscribeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "unsubscribe <recording-id|url>",
+		Short: "Unsubscribe yourself from a recording",
+		Long: `Stop receiving notifications about a Basecamp recording.
+
+Use 'bc4 subscribers' to manage subscriptions for other people.`,
+		Example: `  # Unsubscribe from a message by URL
+  bc4 unsubscribe https://3.basecamp.com/1234567/buckets/89012345/messages/222
+
+  # Unsubscribe from a recording in the current project by ID
+  bc4 unsubscribe 222`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUnsubscribe(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+func runUnsubscribe(f *factory.Factory, opts *unsubscribeOptions, arg string) error {
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, recordingID, err := cmdutil.ResolveRecordingArg(f, arg)
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	if err := client.Subscriptions().Unsubscribe(f.Context(), projectID, recordingID); err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Unsubscribed from #%d\n", recordingID)
+	} else {
+		fmt.Println(recordingID)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type createOptions struct {
+	accountID   string
+	name        string
+	description string
+	jsonOutput  bool
+}
+
+func newCreateCmd(f *factory.Factory) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a project template",
+		Long: `Create a new, empty project template.
+
+Add lists, documents, and other content to it in Basecamp, then create
+projects from it with 'bc4 project create --template'.`,
+		Example: `  # Interactive mode
+  bc4 template create
+
+  # Create with a name and description
+  bc4 template create --name "Sprint" --description "Two-week sprint skeleton"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runCreate(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "Template name")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Template description")
+
+	return cmd
+}
+
+func runCreate(f *factory.Factory, opts *createOptions) error {
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	// Interactive mode if no name provided
+	if opts.name == "" {
+		if err := huh.NewInput().
+			Title("Template Name").
+			Value(&opts.name).
+			Validate(func(s string) error {
+				if s == "" {
+					return fmt.Errorf("template name is required")
+				}
+				return nil
+			}).
+			Run(); err != nil {
+			return err
+		}
+
+		if err := huh.NewText().
+			Title("Description (optional)").
+			Value(&opts.description).
+			Run(); err != nil {
+			return err
+		}
+	}
+
+	template, err := client.Templates().CreateTemplate(f.Context(), api.TemplateRequest{
+		Name:        opts.name,
+		Description: opts.description,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(template)
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Created template: %s (#%d)\n", template.Name, template.ID)
+	} else {
+		fmt.Println(template.ID)
+	}
+
+	return nil
+}
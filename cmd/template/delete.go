@@ -0,0 +1,83 @@
+package template
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+type deleteOptions struct {
+	accountID   string
+	skipConfirm bool
+}
+
+func newDeleteCmd(f *factory.Factory) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <template-id|name|URL>",
+		Short: "Delete a project template",
+		Long:  `Move a project template to the trash. Projects already created from it are not affected.`,
+		Example: `  # Delete a template (with confirmation prompt)
+  bc4 template delete "Old sprint"
+
+  # Delete without confirmation
+  bc4 template delete 12345 --yes`,
+		Aliases: []string{"rm", "remove"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().BoolVarP(&opts.skipConfirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runDelete(f *factory.Factory, opts *deleteOptions, arg string) error {
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	templateOps := client.Templates()
+
+	template, err := ResolveTemplate(f.Context(), templateOps, arg)
+	if err != nil {
+		return err
+	}
+
+	if !opts.skipConfirm {
+		var confirm bool
+		if err := huh.NewConfirm().
+			Title(fmt.Sprintf("Delete template %q?", template.Name)).
+			Affirmative("Delete").
+			Negative("Cancel").
+			Value(&confirm).
+			Run(); err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Canceled")
+			return nil
+		}
+	}
+
+	if err := templateOps.DeleteTemplate(f.Context(), template.ID); err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Deleted template: %s (#%d)\n", template.Name, template.ID)
+	}
+
+	return nil
+}
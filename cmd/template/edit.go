@@ -0,0 +1,97 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type editOptions struct {
+	accountID   string
+	name        string
+	description string
+	jsonOutput  bool
+}
+
+func newEditCmd(f *factory.Factory) *cobra.Command {
+	opts := &editOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit <template-id|name|URL>",
+		Short: "Rename a template or change its description",
+		Long:  `Update a project template's name or description. Only the flags you pass are changed.`,
+		Example: `  # Rename a template
+  bc4 template edit "Sprint" --name "Two-week sprint"
+
+  # Change the description
+  bc4 template edit 12345 --description "Used for all client work"`,
+		Aliases: []string{"update"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runEdit(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "New template name")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "New template description")
+
+	return cmd
+}
+
+func runEdit(f *factory.Factory, opts *editOptions, arg string) error {
+	if opts.name == "" && opts.description == "" {
+		return fmt.Errorf("nothing to update: pass --name or --description")
+	}
+
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	templateOps := client.Templates()
+
+	template, err := ResolveTemplate(f.Context(), templateOps, arg)
+	if err != nil {
+		return err
+	}
+
+	// The API replaces both fields, so carry over whichever one isn't changing
+	req := api.TemplateRequest{
+		Name:        template.Name,
+		Description: template.Description,
+	}
+	if opts.name != "" {
+		req.Name = opts.name
+	}
+	if opts.description != "" {
+		req.Description = opts.description
+	}
+
+	updated, err := templateOps.UpdateTemplate(f.Context(), template.ID, req)
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(updated)
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Updated template: %s (#%d)\n", updated.Name, updated.ID)
+	} else {
+		fmt.Println(updated.ID)
+	}
+
+	return nil
+}
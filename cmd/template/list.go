@@ -0,0 +1,91 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type listOptions struct {
+	accountID  string
+	jsonOutput bool
+}
+
+func newListCmd(f *factory.Factory) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List project templates",
+		Long:  `List all active project templates in the account.`,
+		Example: `  # List templates
+  bc4 template list
+
+  # Output as JSON
+  bc4 template list --json`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runList(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+
+	return cmd
+}
+
+func runList(f *factory.Factory, opts *listOptions) error {
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	templates, err := client.Templates().ListTemplates(f.Context())
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(templates)
+	}
+
+	if len(templates) == 0 {
+		fmt.Println("No templates found.")
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+	if table.IsTTY() {
+		table.AddHeader("ID", "NAME", "DESCRIPTION", "UPDATED")
+	} else {
+		table.AddHeader("ID", "NAME", "DESCRIPTION", "UPDATED", "URL")
+	}
+
+	cs := table.GetColorScheme()
+	now := time.Now()
+	for _, t := range templates {
+		table.AddIDField(strconv.FormatInt(t.ID, 10), t.Status)
+		table.AddField(t.Name, cs.Bold)
+		table.AddField(t.Description, cs.Muted)
+		table.AddTimeField(now, t.UpdatedAt)
+		if !table.IsTTY() {
+			table.AddField(t.AppURL)
+		}
+		table.EndRow()
+	}
+
+	return table.Render()
+}
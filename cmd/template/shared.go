@@ -0,0 +1,73 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// ResolveTemplate finds a template by ID, URL, or name. Names match exactly
+// (case-insensitive) first, then by unique partial match.
+func ResolveTemplate(ctx context.Context, templateOps api.TemplateOperations, identifier string) (*api.Template, error) {
+	identifier = strings.TrimSpace(identifier)
+	if identifier == "" {
+		return nil, fmt.Errorf("template name or ID is required")
+	}
+
+	// Templates live at project URLs, so a project URL's ID is the template ID
+	if parser.IsBasecampURL(identifier) {
+		parsed, err := parser.ParseBasecampURL(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ResourceType != parser.ResourceTypeProject {
+			return nil, fmt.Errorf("URL is not a template URL: %s", identifier)
+		}
+		return templateOps.GetTemplate(ctx, parsed.ResourceID)
+	}
+
+	if id, err := strconv.ParseInt(identifier, 10, 64); err == nil {
+		return templateOps.GetTemplate(ctx, id)
+	}
+
+	templates, err := templateOps.ListTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchTemplate(templates, identifier)
+}
+
+// matchTemplate picks a template by name from a list
+func matchTemplate(templates []api.Template, name string) (*api.Template, error) {
+	for i := range templates {
+		if strings.EqualFold(templates[i].Name, name) {
+			return &templates[i], nil
+		}
+	}
+
+	var matches []*api.Template
+	lowerName := strings.ToLower(name)
+	for i := range templates {
+		if strings.Contains(strings.ToLower(templates[i].Name), lowerName) {
+			matches = append(matches, &templates[i])
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("template not found: %s", name)
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, len(matches))
+		for i, t := range matches {
+			names[i] = t.Name
+		}
+		return nil, fmt.Errorf("template name %q is ambiguous, matches: %s", name, strings.Join(names, ", "))
+	}
+}
@@ -0,0 +1,36 @@
+package template
+
+import (
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewTemplateCmd creates the template command
+func NewTemplateCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage project templates",
+		Long: `Work with Basecamp project templates.
+
+Templates are blueprints for new projects. Create a project from one with
+'bc4 project create --template <name|id>'.`,
+		Example: `  bc4 template list                          # List templates
+  bc4 template view "Client onboarding"      # Show a template
+  bc4 template create --name "Sprint"        # Create an empty template
+  bc4 project create --name "Acme" --template "Client onboarding" --wait`,
+		Aliases: []string{"templates", "tpl"},
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(newListCmd(f))
+	cmd.AddCommand(newViewCmd(f))
+	cmd.AddCommand(newCreateCmd(f))
+	cmd.AddCommand(newEditCmd(f))
+	cmd.AddCommand(newDeleteCmd(f))
+
+	return cmd
+}
@@ -0,0 +1,68 @@
+package template
+
+import (
+	"context"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTemplateOps struct {
+	api.TemplateOperations
+	templates []api.Template
+	gotID     int64
+}
+
+func (f *fakeTemplateOps) ListTemplates(ctx context.Context) ([]api.Template, error) {
+	return f.templates, nil
+}
+
+func (f *fakeTemplateOps) GetTemplate(ctx context.Context, templateID int64) (*api.Template, error) {
+	f.gotID = templateID
+	return &api.Template{ID: templateID}, nil
+}
+
+func TestNewTemplateCmd(t *testing.T) {
+	cmd := NewTemplateCmd(factory.New())
+
+	assert.Equal(t, "template", cmd.Use)
+	for _, name := range []string{"list", "view", "create", "edit", "delete"} {
+		sub, _, err := cmd.Find([]string{name})
+		require.NoError(t, err)
+		assert.Equal(t, name, sub.Name())
+	}
+}
+
+func TestResolveTemplate(t *testing.T) {
+	ops := &fakeTemplateOps{templates: []api.Template{
+		{ID: 1, Name: "Client onboarding"},
+		{ID: 2, Name: "Client offboarding"},
+		{ID: 3, Name: "Sprint"},
+	}}
+	ctx := context.Background()
+
+	tmpl, err := ResolveTemplate(ctx, ops, "sprint")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), tmpl.ID)
+
+	tmpl, err = ResolveTemplate(ctx, ops, "onboard")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), tmpl.ID)
+
+	_, err = ResolveTemplate(ctx, ops, "client")
+	assert.ErrorContains(t, err, "ambiguous")
+
+	_, err = ResolveTemplate(ctx, ops, "missing")
+	assert.ErrorContains(t, err, "not found")
+
+	tmpl, err = ResolveTemplate(ctx, ops, "42")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), tmpl.ID)
+
+	_, err = ResolveTemplate(ctx, ops, "https://3.basecamp.com/1111/projects/2222")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2222), ops.gotID)
+}
@@ -0,0 +1,87 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type viewOptions struct {
+	accountID  string
+	jsonOutput bool
+}
+
+func newViewCmd(f *factory.Factory) *cobra.Command {
+	opts := &viewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "view <template-id|name|URL>",
+		Short: "View a project template",
+		Long:  `View a project template, including the tools it enables.`,
+		Example: `  # View a template by name
+  bc4 template view "Client onboarding"
+
+  # View a template by ID as JSON
+  bc4 template view 12345 --json`,
+		Aliases: []string{"show"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runView(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+
+	return cmd
+}
+
+func runView(f *factory.Factory, opts *viewOptions, arg string) error {
+	f = f.ApplyOverrides(opts.accountID, "")
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	template, err := ResolveTemplate(f.Context(), client.Templates(), arg)
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(template)
+	}
+
+	fmt.Printf("%s (#%d)\n\n", template.Name, template.ID)
+	if template.Description != "" {
+		fmt.Printf("Description: %s\n", template.Description)
+	}
+	fmt.Printf("Status:      %s\n", template.Status)
+	fmt.Printf("Created:     %s\n", template.CreatedAt.Format("Jan 2, 2006 at 3:04 PM"))
+	fmt.Printf("Updated:     %s\n", template.UpdatedAt.Format("Jan 2, 2006 at 3:04 PM"))
+	if template.AppURL != "" {
+		fmt.Printf("URL:         %s\n", template.AppURL)
+	}
+
+	var tools []string
+	for _, tool := range template.Dock {
+		if tool.Enabled {
+			tools = append(tools, tool.Title)
+		}
+	}
+	if len(tools) > 0 {
+		fmt.Printf("\nTools (%d):\n", len(tools))
+		for _, tool := range tools {
+			fmt.Printf("  • %s\n", tool)
+		}
+	}
+
+	return nil
+}
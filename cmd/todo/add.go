@@ -9,6 +9,7 @@ import (
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/attachments"
+	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
 	"github.com/needmore/bc4/internal/parser"
@@ -24,6 +25,7 @@ type addOptions struct {
 	assign      []string
 	file        string
 	attach      []string
+	notify      []string
 }
 
 func newAddCmd(f *factory.Factory) *cobra.Command {
@@ -60,6 +62,9 @@ can be attached by using the flag multiple times.`,
   # Add a todo with multiple attachments
   bc4 todo add "Update assets" --attach ./image1.png --attach ./image2.jpg
 
+  # Add a todo and notify teammates about it
+  bc4 todo add "Plan offsite" --notify @jane,bob@example.com
+
   # Add a todo to a specific group within a list
   bc4 todo add "Fix bug" --list "Sprint Tasks" --group "In Progress"
   bc4 todo add "Review PR" --list 12345 --group 67890`,
@@ -72,10 +77,11 @@ can be attached by using the flag multiple times.`,
 	cmd.Flags().StringVarP(&opts.list, "list", "l", "", "Todo list ID, name, or URL (defaults to selected list)")
 	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Todo group ID, name, or URL within the list (optional)")
 	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description for the todo")
-	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD, or e.g. today, fri, next monday, +3d)")
 	cmd.Flags().StringSliceVar(&opts.assign, "assign", nil, "Assign to team members (by email)")
 	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read todo content from a markdown file")
 	cmd.Flags().StringSliceVar(&opts.attach, "attach", nil, "Attach file(s) to the todo (can be used multiple times)")
+	cmd.Flags().StringSliceVar(&opts.notify, "notify", nil, "Subscribe people to the todo so they are notified (by email or @mention)")
 
 	return cmd
 }
@@ -296,14 +302,22 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 	}
 
 	if opts.due != "" {
-		req.DueOn = &opts.due
+		loc, err := f.Location()
+		if err != nil {
+			return err
+		}
+		due, err := cmdutil.ResolveDate("Due date", opts.due, loc)
+		if err != nil {
+			return err
+		}
+		req.DueOn = &due
 	}
 
+	// Create user resolver for assignees and people to notify
+	userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+
 	// Handle assignee lookup
 	if len(opts.assign) > 0 {
-		// Create user resolver
-		userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
-
 		// Resolve user identifiers to person IDs
 		personIDs, err := userResolver.ResolveUsers(f.Context(), opts.assign)
 		if err != nil {
@@ -313,12 +327,31 @@ func runAdd(f *factory.Factory, opts *addOptions, args []string) error {
 		req.AssigneeIDs = personIDs
 	}
 
+	// Resolve people to notify before creating so typos don't leave a half-done todo
+	var notifyIDs []int64
+	if len(opts.notify) > 0 {
+		notifyIDs, err = userResolver.ResolveUsers(f.Context(), opts.notify)
+		if err != nil {
+			return fmt.Errorf("failed to resolve people to notify: %w", err)
+		}
+	}
+
 	// When posting to a group, the API uses the same endpoint pattern as posting to a list
 	todo, err := todoOps.CreateTodo(f.Context(), resolvedProjectID, targetID, req)
 	if err != nil {
 		return fmt.Errorf("failed to create todo: %w", err)
 	}
 
+	// Subscribe the people to notify
+	if len(notifyIDs) > 0 {
+		_, err := client.Subscriptions().UpdateSubscription(f.Context(), resolvedProjectID, todo.ID, api.SubscriptionUpdateRequest{
+			Subscriptions: notifyIDs,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to notify people: %v\n", err)
+		}
+	}
+
 	// Output the created todo ID (GitHub CLI style - minimal output)
 	fmt.Printf("#%d\n", todo.ID)
 
@@ -0,0 +1,329 @@
+package todo
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// bulkConcurrency bounds how many todos are processed at once
+const bulkConcurrency = 4
+
+// todoTarget is a single todo addressed by a bulk command
+type todoTarget struct {
+	projectID string
+	todoID    int64
+}
+
+// todoFilter selects todos in the current project instead of naming them
+type todoFilter struct {
+	list      string
+	assignee  string
+	dueBefore string
+}
+
+func (w *todoFilter) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&w.list, "list", "", "Select todos in this list (ID, name, or URL) instead of passing IDs")
+	cmd.Flags().StringVar(&w.assignee, "assignee", "", "Select todos assigned to this person (name, email, or \"me\")")
+	cmd.Flags().StringVar(&w.dueBefore, "due-before", "", "Select todos due before this date (YYYY-MM-DD, or e.g. fri, +7d)")
+}
+
+func (w *todoFilter) isSet() bool {
+	return w.list != "" || w.assignee != "" || w.dueBefore != ""
+}
+
+// collectTodoTargets turns ID/URL arguments ("-" reads IDs from stdin) or a
+// filter into the list of todos to operate on. Filters select incomplete
+// todos, or completed ones when completed is true. Accounts and projects in
+// URLs are only used when the --account and --project flags aren't set.
+func collectTodoTargets(f *factory.Factory, args []string, filter *todoFilter, completed bool, accountIDFlag, projectIDFlag string) (*factory.Factory, []todoTarget, error) {
+	if filter.isSet() && len(args) > 0 {
+		return nil, nil, fmt.Errorf("pass todo IDs or filter flags, not both")
+	}
+	if !filter.isSet() && len(args) == 0 {
+		return nil, nil, fmt.Errorf("no todos specified: pass IDs or URLs, - to read them from stdin, or filter with --list, --assignee, or --due-before")
+	}
+
+	if filter.isSet() {
+		targets, err := filterTodoTargets(f, filter, completed)
+		return f, targets, err
+	}
+
+	args, err := expandStdinArgs(args, os.Stdin)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	type parsedArg struct {
+		todoID    int64
+		projectID string
+	}
+	parsed := make([]parsedArg, 0, len(args))
+	accountID := ""
+	for _, arg := range args {
+		todoID, parsedURL, err := parser.ParseArgument(strings.TrimPrefix(arg, "#"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid todo ID or URL: %s", arg)
+		}
+		p := parsedArg{todoID: todoID}
+		if parsedURL != nil {
+			if parsedURL.ResourceType != parser.ResourceTypeTodo {
+				return nil, nil, fmt.Errorf("URL is not for a todo: %s", arg)
+			}
+			if accountIDFlag == "" && parsedURL.AccountID > 0 {
+				id := strconv.FormatInt(parsedURL.AccountID, 10)
+				if accountID != "" && accountID != id {
+					return nil, nil, fmt.Errorf("todos from different accounts can't be combined in one command")
+				}
+				accountID = id
+			}
+			if projectIDFlag == "" && parsedURL.ProjectID > 0 {
+				p.projectID = strconv.FormatInt(parsedURL.ProjectID, 10)
+			}
+		}
+		parsed = append(parsed, p)
+	}
+
+	if accountID != "" {
+		f = f.WithAccount(accountID)
+	}
+
+	targets := make([]todoTarget, 0, len(parsed))
+	defaultProjectID := ""
+	for _, p := range parsed {
+		if p.projectID == "" {
+			if defaultProjectID == "" {
+				projectID, err := f.ProjectID()
+				if err != nil {
+					return nil, nil, err
+				}
+				defaultProjectID = projectID
+			}
+			p.projectID = defaultProjectID
+		}
+		targets = append(targets, todoTarget{projectID: p.projectID, todoID: p.todoID})
+	}
+
+	return f, targets, nil
+}
+
+// expandStdinArgs replaces a "-" argument with the newline-separated IDs read from r
+func expandStdinArgs(args []string, r io.Reader) ([]string, error) {
+	var expanded []string
+	readStdin := false
+	for _, arg := range args {
+		if arg != "-" {
+			expanded = append(expanded, arg)
+			continue
+		}
+		if readStdin {
+			continue
+		}
+		readStdin = true
+
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" {
+				expanded = append(expanded, line)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read todo IDs from stdin: %w", err)
+		}
+	}
+
+	if len(expanded) == 0 {
+		return nil, fmt.Errorf("no todo IDs read from stdin")
+	}
+	return expanded, nil
+}
+
+// filterTodoTargets selects todos in the current project matching the filter
+func filterTodoTargets(f *factory.Factory, filter *todoFilter, completed bool) ([]todoTarget, error) {
+	client, err := f.ApiClient()
+	if err != nil {
+		return nil, err
+	}
+	todoOps := client.Todos()
+	ctx := f.Context()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return nil, err
+	}
+
+	var lists []api.TodoList
+	if filter.list != "" {
+		list, err := resolveTodoList(f, client, projectID, filter.list)
+		if err != nil {
+			return nil, err
+		}
+		lists = []api.TodoList{*list}
+	} else {
+		todoSet, err := todoOps.GetProjectTodoSet(ctx, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project todo set: %w", err)
+		}
+		lists, err = todoOps.GetTodoLists(ctx, projectID, todoSet.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch todo lists: %w", err)
+		}
+	}
+
+	var assigneeID int64
+	if filter.assignee != "" {
+		if strings.EqualFold(filter.assignee, "me") {
+			me, err := client.GetMyProfile(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get your profile: %w", err)
+			}
+			assigneeID = me.ID
+		} else {
+			ids, err := utils.NewUserResolver(client.Client, projectID).ResolveUsers(ctx, []string{filter.assignee})
+			if err != nil {
+				return nil, err
+			}
+			assigneeID = ids[0]
+		}
+	}
+
+	dueBefore := ""
+	if filter.dueBefore != "" {
+		loc, err := f.Location()
+		if err != nil {
+			return nil, err
+		}
+		dueBefore, err = cmdutil.ResolveDate("Due before", filter.dueBefore, loc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var todos []api.Todo
+	for _, list := range lists {
+		listTodos, err := fetchListTodos(ctx, todoOps, projectID, list, completed)
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, listTodos...)
+	}
+
+	var targets []todoTarget
+	for _, todo := range todos {
+		if todo.Completed != completed {
+			continue
+		}
+		if assigneeID != 0 && !assignedTo(todo.Assignees, assigneeID) {
+			continue
+		}
+		if dueBefore != "" && (todo.DueOn == nil || *todo.DueOn == "" || *todo.DueOn >= dueBefore) {
+			continue
+		}
+		targets = append(targets, todoTarget{projectID: projectID, todoID: todo.ID})
+	}
+
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no todos match the filter")
+	}
+	return targets, nil
+}
+
+// fetchListTodos returns the todos in a list and its groups
+func fetchListTodos(ctx context.Context, todoOps api.TodoOperations, projectID string, list api.TodoList, completed bool) ([]api.Todo, error) {
+	fetch := todoOps.GetTodos
+	if completed {
+		fetch = todoOps.GetAllTodos
+	}
+
+	todos, err := fetch(ctx, projectID, list.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch todos: %w", err)
+	}
+
+	if list.GroupsURL == "" {
+		return todos, nil
+	}
+	groups, err := todoOps.GetTodoGroups(ctx, projectID, list.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch todo groups: %w", err)
+	}
+	for _, group := range groups {
+		groupTodos, err := fetch(ctx, projectID, group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch todos: %w", err)
+		}
+		todos = append(todos, groupTodos...)
+	}
+	return todos, nil
+}
+
+func assignedTo(people []api.Person, personID int64) bool {
+	for _, person := range people {
+		if person.ID == personID {
+			return true
+		}
+	}
+	return false
+}
+
+// runBulk applies fn to every target with bounded concurrency, pacing
+// requests through the shared rate limiter. A single target reports its
+// result directly; multiple targets get a per-item log and a summary, and a
+// non-zero exit if anything failed.
+func runBulk(targets []todoTarget, fn func(todoTarget) (string, error)) error {
+	if len(targets) == 1 {
+		msg, err := fn(targets[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(msg)
+		return nil
+	}
+
+	limiter := api.GetRateLimiter()
+	var mu sync.Mutex
+	failed := 0
+
+	g := new(errgroup.Group)
+	g.SetLimit(bulkConcurrency)
+	for _, target := range targets {
+		g.Go(func() error {
+			limiter.Wait()
+			msg, err := fn(target)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed++
+				fmt.Fprintf(os.Stderr, "✗ #%d: %v\n", target.todoID, err)
+			} else {
+				fmt.Println(msg)
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	succeeded := len(targets) - failed
+	fmt.Fprintf(os.Stderr, "\n%d succeeded, %d failed\n", succeeded, failed)
+
+	if failed > 0 {
+		return cmdutil.NewSilentError(fmt.Errorf("%d of %d todos failed", failed, len(targets)))
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+)
+
+func TestBulkCommandFlags(t *testing.T) {
+	f := &factory.Factory{}
+	for _, name := range []string{"list", "assignee", "due-before"} {
+		assert.NotNil(t, newCheckCmd(f).Flag(name), "check flag %s should exist", name)
+		assert.NotNil(t, newUncheckCmd(f).Flag(name), "uncheck flag %s should exist", name)
+		assert.NotNil(t, newEditCmd(f).Flag(name), "edit flag %s should exist", name)
+	}
+}
+
+func TestCollectTodoTargetsRequiresOneInput(t *testing.T) {
+	f := &factory.Factory{}
+
+	_, _, err := collectTodoTargets(f, nil, &todoFilter{}, false, "", "")
+	assert.ErrorContains(t, err, "no todos specified")
+
+	_, _, err = collectTodoTargets(f, []string{"123"}, &todoFilter{list: "Sprint"}, false, "", "")
+	assert.ErrorContains(t, err, "not both")
+}
+
+func TestExpandStdinArgs(t *testing.T) {
+	args, err := expandStdinArgs([]string{"1", "-", "4"}, strings.NewReader("2\n\n  3  \n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3", "4"}, args)
+
+	_, err = expandStdinArgs([]string{"-"}, strings.NewReader("\n"))
+	assert.ErrorContains(t, err, "no todo IDs")
+}
+
+func TestMergeAssigneeIDs(t *testing.T) {
+	current := []api.Person{{ID: 1}, {ID: 2}}
+
+	assert.Equal(t, []int64{1, 2, 3}, mergeAssigneeIDs(current, []int64{2, 3}, nil))
+	assert.Equal(t, []int64{2}, mergeAssigneeIDs(current, nil, []int64{1}))
+	assert.Equal(t, []int64{1}, mergeAssigneeIDs(current, []int64{3}, []int64{2, 3}))
+}
+
+func TestCollectTodoTargetsFlagsOverrideURLs(t *testing.T) {
+	url := "https://3.basecamp.com/1234567/buckets/89012345/todos/12345"
+
+	f, targets, err := collectTodoTargets((&factory.Factory{}).WithAccount("999").WithProject("42"), []string{url}, &todoFilter{}, false, "999", "42")
+	require.NoError(t, err)
+	assert.Equal(t, []todoTarget{{projectID: "42", todoID: 12345}}, targets)
+	accountID, err := f.AccountID()
+	require.NoError(t, err)
+	assert.Equal(t, "999", accountID)
+
+	f, targets, err = collectTodoTargets(&factory.Factory{}, []string{url}, &todoFilter{}, false, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, []todoTarget{{projectID: "89012345", todoID: 12345}}, targets)
+	accountID, err = f.AccountID()
+	require.NoError(t, err)
+	assert.Equal(t, "1234567", accountID)
+}
@@ -0,0 +1,357 @@
+package todo
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/attachments"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+type copyListOptions struct {
+	toProject       string
+	name            string
+	resetCompletion bool
+	shiftDates      string
+}
+
+// listCopier recreates todos in another list, applying the copy options
+type listCopier struct {
+	ctx             context.Context
+	todoOps         api.TodoOperations
+	converter       markdown.Converter
+	uploads         api.UploadOperations
+	attachmentOps   api.AttachmentOperations
+	sourceProjectID string
+	targetProjectID string
+	shiftDays       int
+	resetCompletion bool
+	assigneesByMail map[string]int64
+	unmapped        map[string]bool
+	todosCopied     int
+}
+
+func newCopyListCmd(f *factory.Factory) *cobra.Command {
+	opts := &copyListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "copy-list <list-id|name|url>",
+		Short: "Copy a todo list, with its groups and todos, to a project",
+		Long: `Duplicate a todo list, including its groups (with their colors and order),
+todos, descriptions, due dates and assignees.
+
+The copy goes to the current project unless --to-project is given. Assignees
+are matched by email address in the destination project; anyone without
+access there is left unassigned with a warning.
+
+Use --reset-completion to start every todo unchecked, and --shift-dates to
+move all due and start dates, which is handy when reusing a checklist.`,
+		Example: `  # Duplicate a list in the current project
+  bc4 todo copy-list "Onboarding"
+
+  # Reuse the onboarding checklist for a new client, two weeks out
+  bc4 todo copy-list "Onboarding" --to-project "Acme Corp" --reset-completion --shift-dates +14d
+
+  # Copy under a new name
+  bc4 todo copy-list 12345 --to-project 67890 --name "Onboarding – Acme"`,
+		Args: cmdutil.ExactArgs(1, "list"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCopyList(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.toProject, "to-project", "", "Destination project ID, name, or URL (defaults to the current project)")
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "Name for the new list (defaults to the original name)")
+	cmd.Flags().BoolVar(&opts.resetCompletion, "reset-completion", false, "Create every todo as incomplete")
+	cmd.Flags().StringVar(&opts.shiftDates, "shift-dates", "", "Shift due and start dates, e.g. +14d, -1w")
+
+	return cmd
+}
+
+func runCopyList(f *factory.Factory, opts *copyListOptions, listArg string) error {
+	shiftDays := 0
+	if opts.shiftDates != "" {
+		var err error
+		shiftDays, err = parseDateShift(opts.shiftDates)
+		if err != nil {
+			return fmt.Errorf("invalid --shift-dates value: %w", err)
+		}
+	}
+
+	// A list URL carries its own account and project
+	if parser.IsBasecampURL(listArg) {
+		parsed, err := parser.ParseBasecampURL(listArg)
+		if err != nil {
+			return fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
+		}
+		if parsed.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsed.ProjectID, 10))
+		}
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	todoOps := client.Todos()
+	ctx := f.Context()
+
+	sourceProjectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	source, err := resolveTodoList(f, client, sourceProjectID, listArg)
+	if err != nil {
+		return err
+	}
+
+	targetProjectID := sourceProjectID
+	targetName := ""
+	if opts.toProject != "" {
+		project, err := utils.ResolveProject(f.Context(), client.Projects(), opts.toProject)
+		if err != nil {
+			return err
+		}
+		targetProjectID = strconv.FormatInt(project.ID, 10)
+		targetName = project.Name
+	}
+
+	name := opts.name
+	if name == "" {
+		name = source.Title
+		if targetProjectID == sourceProjectID {
+			name += " (copy)"
+		}
+	}
+
+	copier := &listCopier{
+		ctx:             ctx,
+		todoOps:         todoOps,
+		converter:       markdown.NewConverter(),
+		uploads:         client.Uploads(),
+		attachmentOps:   client.Attachments(),
+		sourceProjectID: sourceProjectID,
+		targetProjectID: targetProjectID,
+		shiftDays:       shiftDays,
+		resetCompletion: opts.resetCompletion,
+		unmapped:        make(map[string]bool),
+	}
+
+	people, err := client.People().GetProjectPeople(ctx, targetProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch destination project people: %w", err)
+	}
+	copier.assigneesByMail = assigneeEmailMap(people)
+
+	groups, err := todoOps.GetTodoGroups(ctx, sourceProjectID, source.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch todo groups: %w", err)
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Position < groups[j].Position })
+
+	todoSet, err := todoOps.GetProjectTodoSet(ctx, targetProjectID)
+	if err != nil {
+		return fmt.Errorf("failed to get destination todo set: %w", err)
+	}
+
+	newList, err := todoOps.CreateTodoList(ctx, targetProjectID, todoSet.ID, api.TodoListCreateRequest{
+		Name:        name,
+		Description: copier.convertRichText(source.Description),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := copier.copyTodos(source.ID, newList.ID); err != nil {
+		return err
+	}
+
+	// Groups are created in their original order so positions carry over
+	for _, group := range groups {
+		newGroup, err := todoOps.CreateTodoGroup(ctx, targetProjectID, newList.ID, api.TodoGroupCreateRequest{
+			Name:  group.Title,
+			Color: group.Color,
+		})
+		if err != nil {
+			return err
+		}
+		if err := copier.copyTodos(group.ID, newGroup.ID); err != nil {
+			return err
+		}
+	}
+
+	for email := range copier.unmapped {
+		fmt.Fprintf(os.Stderr, "Warning: %s has no access to the destination project and was left unassigned\n", email)
+	}
+
+	if !ui.IsTerminal(os.Stdout) {
+		fmt.Println(newList.ID)
+		return nil
+	}
+
+	destination := ""
+	if targetName != "" {
+		destination = " in " + targetName
+	}
+	fmt.Printf("✓ Copied \"%s\" to \"%s\"%s (#%d): %d groups, %d todos\n",
+		source.Title, name, destination, newList.ID, len(groups), copier.todosCopied)
+	return nil
+}
+
+// copyTodos recreates all todos (including completed ones) from one list or
+// group in another, preserving their order
+func (c *listCopier) copyTodos(sourceID, targetID int64) error {
+	todos, err := c.todoOps.GetAllTodos(c.ctx, c.sourceProjectID, sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch todos: %w", err)
+	}
+
+	for _, todo := range todos {
+		title := todo.Content
+		if title == "" {
+			title = todo.Title
+		}
+		req := api.TodoCreateRequest{
+			Content:     title,
+			Description: c.convertRichText(todo.Description),
+			DueOn:       shiftDate(todo.DueOn, c.shiftDays),
+			StartsOn:    shiftDate(todo.StartsOn, c.shiftDays),
+			AssigneeIDs: c.mapAssignees(todo.Assignees),
+		}
+
+		newTodo, err := c.todoOps.CreateTodo(c.ctx, c.targetProjectID, targetID, req)
+		if err != nil {
+			return fmt.Errorf("failed to copy todo %q: %w", todo.Title, err)
+		}
+		c.todosCopied++
+
+		if todo.Completed && !c.resetCompletion {
+			if err := c.todoOps.CompleteTodo(c.ctx, c.targetProjectID, newTodo.ID); err != nil {
+				return fmt.Errorf("failed to complete copied todo #%d: %w", newTodo.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// convertRichText normalizes rich text through a Markdown round-trip. The
+// conversion would drop embedded attachments, so they are swapped for
+// placeholders while the text around them is converted. Copies to another
+// project re-upload the attachments there.
+func (c *listCopier) convertRichText(content string) string {
+	if content == "" {
+		return content
+	}
+
+	if c.targetProjectID != c.sourceProjectID {
+		rehosted, errs := utils.RehostAttachments(c.ctx, c.uploads, c.attachmentOps, c.sourceProjectID, content)
+		for _, err := range errs {
+			fmt.Fprintf(os.Stderr, "Warning: attachment not copied: %v\n", err)
+		}
+		content = rehosted
+	}
+
+	var tags []string
+	text := attachments.ReplaceAttachments(content, func(tag string, _ attachments.Attachment) string {
+		tags = append(tags, tag)
+		return attachmentPlaceholder(len(tags) - 1)
+	})
+
+	md, err := c.converter.RichTextToMarkdown(text)
+	if err != nil {
+		return content
+	}
+	richText, err := c.converter.MarkdownToRichText(md)
+	if err != nil {
+		return content
+	}
+
+	for i, tag := range tags {
+		placeholder := attachmentPlaceholder(i)
+		if !strings.Contains(richText, placeholder) {
+			return content
+		}
+		richText = strings.Replace(richText, placeholder, tag, 1)
+	}
+	return richText
+}
+
+// attachmentPlaceholder is a token that survives the Markdown round-trip
+// unchanged
+func attachmentPlaceholder(i int) string {
+	return fmt.Sprintf("BC4ATTACHMENT%dPLACEHOLDER", i)
+}
+
+// mapAssignees maps assignees to people in the destination project by email
+func (c *listCopier) mapAssignees(assignees []api.Person) []int64 {
+	var ids []int64
+	for _, person := range assignees {
+		if id, ok := c.assigneesByMail[strings.ToLower(person.EmailAddress)]; ok {
+			ids = append(ids, id)
+		} else {
+			c.unmapped[person.EmailAddress] = true
+		}
+	}
+	return ids
+}
+
+func assigneeEmailMap(people []api.Person) map[string]int64 {
+	m := make(map[string]int64, len(people))
+	for _, person := range people {
+		if person.EmailAddress != "" {
+			m[strings.ToLower(person.EmailAddress)] = person.ID
+		}
+	}
+	return m
+}
+
+// parseDateShift parses a signed day offset such as "+14d", "-1w" or "3"
+func parseDateShift(value string) (int, error) {
+	s := strings.TrimSpace(value)
+	sign := 1
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign = -1
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	days, err := parseDays(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid date shift %q (use e.g. +14d or -1w)", value)
+	}
+	return sign * days, nil
+}
+
+// shiftDate moves a YYYY-MM-DD date by a number of days. Unparseable dates
+// are returned unchanged.
+func shiftDate(date *string, days int) *string {
+	if date == nil || *date == "" || days == 0 {
+		return date
+	}
+	t, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		return date
+	}
+	shifted := t.AddDate(0, 0, days).Format("2006-01-02")
+	return &shifted
+}
@@ -0,0 +1,145 @@
+package todo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+func TestParseDateShift(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{"+14d", 14, false},
+		{"14d", 14, false},
+		{"-1w", -7, false},
+		{"+2w", 14, false},
+		{"3", 3, false},
+		{"next week", 0, true},
+		{"+", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseDateShift(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestShiftDate(t *testing.T) {
+	date := "2024-02-20"
+	assert.Equal(t, "2024-03-05", *shiftDate(&date, 14))
+	assert.Equal(t, "2024-02-13", *shiftDate(&date, -7))
+	assert.Same(t, &date, shiftDate(&date, 0))
+	assert.Nil(t, shiftDate(nil, 14))
+
+	bad := "someday"
+	assert.Equal(t, "someday", *shiftDate(&bad, 3))
+}
+
+func TestListCopierCopyTodos(t *testing.T) {
+	due := "2024-01-10"
+	client := mock.NewMockClient()
+	client.Todos = []api.Todo{
+		{ID: 1, Title: "Kickoff", Content: "Kickoff", DueOn: &due, Assignees: []api.Person{{EmailAddress: "Jane@Example.com"}}},
+		{ID: 2, Title: "Contract", Content: "Contract", Assignees: []api.Person{{EmailAddress: "bob@example.com"}}},
+	}
+	client.CreatedTodo = &api.Todo{ID: 99}
+
+	copier := &listCopier{
+		ctx:             context.Background(),
+		todoOps:         client,
+		converter:       markdown.NewConverter(),
+		sourceProjectID: "1",
+		targetProjectID: "2",
+		shiftDays:       7,
+		assigneesByMail: assigneeEmailMap([]api.Person{{ID: 42, EmailAddress: "jane@example.com"}}),
+		unmapped:        make(map[string]bool),
+	}
+
+	require.NoError(t, copier.copyTodos(10, 20))
+	assert.Equal(t, 2, copier.todosCopied)
+	assert.Equal(t, map[string]bool{"bob@example.com": true}, copier.unmapped)
+
+	var creates []string
+	for _, call := range client.Calls {
+		if strings.HasPrefix(call, "CreateTodo(2, 20,") {
+			creates = append(creates, call)
+		}
+	}
+	require.Len(t, creates, 2)
+	assert.Contains(t, creates[0], "AssigneeIDs:[42]")
+	assert.Contains(t, creates[1], "AssigneeIDs:[]")
+}
+
+func TestListCopierCompletion(t *testing.T) {
+	client := mock.NewMockClient()
+	client.Todos = []api.Todo{{ID: 1, Title: "Done", Completed: true}}
+	client.CreatedTodo = &api.Todo{ID: 99}
+
+	copier := &listCopier{ctx: context.Background(), todoOps: client, converter: markdown.NewConverter(), targetProjectID: "2", unmapped: map[string]bool{}}
+	require.NoError(t, copier.copyTodos(10, 20))
+	assert.Contains(t, client.Calls, "CompleteTodo(2, 99)")
+
+	client.Calls = nil
+	copier.resetCompletion = true
+	require.NoError(t, copier.copyTodos(10, 20))
+	assert.NotContains(t, client.Calls, "CompleteTodo(2, 99)")
+}
+
+func TestListCopierConvertRichText(t *testing.T) {
+	copier := &listCopier{converter: markdown.NewConverter()}
+
+	assert.Equal(t, "", copier.convertRichText(""))
+
+	tag := `<bc-attachment sgid="abc"></bc-attachment>`
+	converted := copier.convertRichText(`<div><strong>See</strong> ` + tag + `</div>`)
+	assert.Contains(t, converted, tag)
+	assert.Contains(t, converted, "<strong>See</strong>")
+	assert.NotContains(t, converted, "PLACEHOLDER")
+
+	assert.Contains(t, copier.convertRichText("<div><strong>Bold</strong> text</div>"), "<strong>Bold</strong>")
+}
+
+type failingUploads struct{ calls int }
+
+func (u *failingUploads) GetUpload(ctx context.Context, bucketID string, uploadID int64) (*api.Upload, error) {
+	u.calls++
+	return nil, errors.New("not found")
+}
+
+func (u *failingUploads) DownloadAttachment(ctx context.Context, downloadURL, destPath string) error {
+	u.calls++
+	return errors.New("not found")
+}
+
+func TestListCopierConvertRichTextRehostsAcrossProjects(t *testing.T) {
+	uploads := &failingUploads{}
+	tag := `<bc-attachment sgid="abc" href="https://3.basecamp.com/1/buckets/1/uploads/7" filename="plan.pdf"></bc-attachment>`
+	content := `<div>Plan ` + tag + `</div>`
+
+	copier := &listCopier{ctx: context.Background(), converter: markdown.NewConverter(), uploads: uploads, sourceProjectID: "1", targetProjectID: "1"}
+	copier.convertRichText(content)
+	assert.Zero(t, uploads.calls, "attachments stay put within a project")
+
+	// Attachments that can't be re-uploaded keep their original tag
+	copier.targetProjectID = "2"
+	assert.Contains(t, copier.convertRichText(content), tag)
+	assert.NotZero(t, uploads.calls)
+}
@@ -0,0 +1,535 @@
+package todo
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	bcerrors "github.com/needmore/bc4/internal/errors"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// dueScanConcurrency bounds how many projects are scanned at once
+const dueScanConcurrency = 4
+
+// doneColumnType is the card table column type for completed cards
+const doneColumnType = "Kanban::DoneColumn"
+
+type dueOptions struct {
+	accountID   string
+	projects    []string
+	allProjects bool
+	overdue     bool
+	within      string
+	assignee    string
+	formatStr   string
+	exitCode    bool
+	noCards     bool
+}
+
+// dueItem is a todo or card with a due date, as shown in the due report
+type dueItem struct {
+	Type      string   `json:"type"`
+	ID        int64    `json:"id"`
+	Title     string   `json:"title"`
+	ProjectID int64    `json:"project_id"`
+	Project   string   `json:"project"`
+	List      string   `json:"list"`
+	Assignees []string `json:"assignees"`
+	DueOn     string   `json:"due_on"`
+	DaysLate  int      `json:"days_late"`
+
+	assigneeEmails []string
+}
+
+func newDueCmd(f *factory.Factory) *cobra.Command {
+	opts := &dueOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "due",
+		Short: "Report overdue and soon-due todos and cards",
+		Long: `Scan todos and cards with a due date and report the ones that are overdue
+or coming due soon, sorted with the most overdue first.
+
+By default the current project is scanned. Use --project (repeatable, ID or
+name) to pick projects, or --all-projects to scan every project in the account.
+
+With --exit-code the command exits with status 1 when any overdue items are
+found, which makes it suitable for cron jobs and CI checks. If a project
+couldn't be scanned it exits with status 3 instead, so a failed scan never
+passes as a clean one.`,
+		Example: `  # Overdue items and anything due in the next 7 days
+  bc4 todo due
+
+  # Only overdue items, across every project
+  bc4 todo due --overdue --all-projects
+
+  # Due within two weeks in specific projects
+  bc4 todo due --within 2w --project "Website" --project 12345
+
+  # Only items assigned to someone
+  bc4 todo due --assignee jane@example.com
+
+  # Fail a CI job when anything is overdue
+  bc4 todo due --overdue --all-projects --exit-code`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.accountID != "" {
+				f = f.WithAccount(opts.accountID)
+			}
+			if opts.allProjects && len(opts.projects) > 0 {
+				return fmt.Errorf("--project and --all-projects cannot be used together")
+			}
+			if opts.overdue && cmd.Flags().Changed("within") {
+				return fmt.Errorf("--overdue and --within cannot be used together")
+			}
+			return runDue(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringSliceVarP(&opts.projects, "project", "p", nil, "Project ID or name to scan (repeatable)")
+	cmd.Flags().BoolVar(&opts.allProjects, "all-projects", false, "Scan every project in the account")
+	cmd.Flags().BoolVar(&opts.overdue, "overdue", false, "Only show overdue items")
+	cmd.Flags().StringVar(&opts.within, "within", "7d", "Also show items due within this window (e.g. 3d, 2w)")
+	cmd.Flags().StringVar(&opts.assignee, "assignee", "", "Only show items assigned to this person (name, email, or \"me\")")
+	cmd.Flags().StringVarP(&opts.formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().BoolVar(&opts.exitCode, "exit-code", false, "Exit with status 1 when overdue items exist, or 3 when a project couldn't be scanned")
+	cmd.Flags().BoolVar(&opts.noCards, "no-cards", false, "Skip cards and only scan todos")
+
+	return cmd
+}
+
+func runDue(f *factory.Factory, opts *dueOptions) error {
+	format, err := ui.ParseOutputFormat(opts.formatStr)
+	if err != nil {
+		return err
+	}
+
+	withinDays := -1
+	if !opts.overdue {
+		withinDays, err = parseDays(opts.within)
+		if err != nil {
+			return fmt.Errorf("invalid --within value: %w", err)
+		}
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	projects, err := resolveDueProjects(f, client, opts)
+	if err != nil {
+		return err
+	}
+
+	assignee := strings.TrimSpace(opts.assignee)
+	if strings.EqualFold(assignee, "me") {
+		me, err := client.GetMyProfile(f.Context())
+		if err != nil {
+			return fmt.Errorf("failed to get your profile: %w", err)
+		}
+		assignee = me.EmailAddress
+	}
+
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+	today := time.Now().In(loc)
+	results := make([][]dueItem, len(projects))
+	scanErrs := make([]error, len(projects))
+
+	g, gctx := errgroup.WithContext(f.Context())
+	g.SetLimit(dueScanConcurrency)
+	for i, project := range projects {
+		g.Go(func() error {
+			var cardOps api.CardOperations
+			if !opts.noCards {
+				cardOps = client.Cards()
+			}
+			results[i], scanErrs[i] = scanProjectDue(gctx, client.Todos(), cardOps, project, today)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var items []dueItem
+	skipped := 0
+	for i, project := range projects {
+		if scanErrs[i] != nil {
+			// A single project failing (e.g. todos disabled) shouldn't sink the
+			// report; whatever was scanned before the failure is still shown
+			fmt.Fprintf(os.Stderr, "Warning: skipped %s: %v\n", project.Name, scanErrs[i])
+			skipped++
+		}
+		items = append(items, results[i]...)
+	}
+
+	items = filterDueItems(items, withinDays, assignee)
+	sortDueItems(items)
+
+	switch format {
+	case ui.OutputFormatJSON:
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(items); err != nil {
+			return err
+		}
+	case ui.OutputFormatCSV:
+		if err := writeDueItemsCSV(items); err != nil {
+			return err
+		}
+	default:
+		if err := renderDueItems(items, len(projects), loc); err != nil {
+			return err
+		}
+	}
+
+	if opts.exitCode {
+		if skipped > 0 {
+			return cmdutil.NewExitCodeError(cmdutil.ExitIncomplete,
+				cmdutil.NewSilentError(fmt.Errorf("%d projects could not be scanned", skipped)))
+		}
+		overdue := 0
+		for _, item := range items {
+			if item.DaysLate > 0 {
+				overdue++
+			}
+		}
+		if overdue > 0 {
+			return cmdutil.NewSilentError(fmt.Errorf("%d overdue items", overdue))
+		}
+	}
+
+	return nil
+}
+
+// parseDays parses a day count such as "7", "7d" or "2w"
+func parseDays(value string) (int, error) {
+	s := strings.ToLower(strings.TrimSpace(value))
+	multiplier := 1
+	switch {
+	case strings.HasSuffix(s, "w"):
+		multiplier = 7
+		s = strings.TrimSuffix(s, "w")
+	case strings.HasSuffix(s, "d"):
+		s = strings.TrimSuffix(s, "d")
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid duration %q (use e.g. 3d or 2w)", value)
+	}
+	return n * multiplier, nil
+}
+
+// resolveDueProjects returns the projects the report should cover
+func resolveDueProjects(f *factory.Factory, client *api.ModularClient, opts *dueOptions) ([]api.Project, error) {
+	if !opts.allProjects && len(opts.projects) == 0 {
+		projectID, err := f.ProjectID()
+		if err != nil {
+			return nil, fmt.Errorf("%w (or use --project / --all-projects)", err)
+		}
+		project, err := client.Projects().GetProject(f.Context(), projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch project: %w", err)
+		}
+		return []api.Project{*project}, nil
+	}
+
+	all, err := client.Projects().GetProjects(f.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	if opts.allProjects {
+		return all, nil
+	}
+
+	var projects []api.Project
+	seen := make(map[int64]bool)
+	for _, identifier := range opts.projects {
+		project, err := utils.MatchProject(all, identifier)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[project.ID] {
+			seen[project.ID] = true
+			projects = append(projects, project)
+		}
+	}
+	return projects, nil
+}
+
+// scanProjectDue collects incomplete todos and cards with a due date in a
+// project. Cards are skipped when cardOps is nil.
+func scanProjectDue(ctx context.Context, todoOps api.TodoOperations, cardOps api.CardOperations, project api.Project, today time.Time) ([]dueItem, error) {
+	projectID := strconv.FormatInt(project.ID, 10)
+	var items []dueItem
+
+	addTodos := func(todos []api.Todo, list string) {
+		for _, todo := range todos {
+			if todo.Completed {
+				continue
+			}
+			if item, ok := newDueItem("todo", todo.ID, todo.Title, todo.DueOn, todo.Assignees, project, list, today); ok {
+				items = append(items, item)
+			}
+		}
+	}
+
+	todoSet, err := todoOps.GetProjectTodoSet(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	lists, err := todoOps.GetTodoLists(ctx, projectID, todoSet.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, list := range lists {
+		if list.Completed {
+			continue
+		}
+		todos, err := todoOps.GetTodos(ctx, projectID, list.ID)
+		if err != nil {
+			return nil, err
+		}
+		addTodos(todos, list.Title)
+
+		if list.GroupsURL == "" {
+			continue
+		}
+		groups, err := todoOps.GetTodoGroups(ctx, projectID, list.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			todos, err := todoOps.GetTodos(ctx, projectID, group.ID)
+			if err != nil {
+				return nil, err
+			}
+			addTodos(todos, list.Title+" › "+group.Title)
+		}
+	}
+
+	if cardOps == nil {
+		return items, nil
+	}
+
+	tables, err := cardOps.GetAllProjectCardTables(ctx, projectID)
+	if errors.Is(err, api.ErrNoCardTables) || bcerrors.IsNotFoundError(err) {
+		// Projects without a card table are common; only todos are reported
+		return items, nil
+	}
+	if err != nil {
+		return items, fmt.Errorf("failed to fetch card tables: %w", err)
+	}
+	for _, table := range tables {
+		for _, column := range table.Lists {
+			if column.Type == doneColumnType {
+				continue
+			}
+			cards, err := cardOps.GetCardsInColumn(ctx, projectID, column.ID)
+			if err != nil {
+				return items, err
+			}
+			if column.OnHold.Enabled {
+				onHold, err := cardOps.GetOnHoldCardsInColumn(ctx, column.OnHold.CardsURL)
+				if err != nil {
+					return items, err
+				}
+				cards = append(cards, onHold...)
+			}
+			for _, card := range cards {
+				if item, ok := newDueItem("card", card.ID, card.Title, card.DueOn, card.Assignees, project, table.Title+" › "+column.Title, today); ok {
+					items = append(items, item)
+				}
+			}
+		}
+	}
+
+	return items, nil
+}
+
+func newDueItem(kind string, id int64, title string, dueOn *string, assignees []api.Person, project api.Project, list string, today time.Time) (dueItem, bool) {
+	days, ok := utils.DaysUntilDue(dueOn, today)
+	if !ok {
+		return dueItem{}, false
+	}
+
+	item := dueItem{
+		Type:      kind,
+		ID:        id,
+		Title:     title,
+		ProjectID: project.ID,
+		Project:   project.Name,
+		List:      list,
+		Assignees: []string{},
+		DueOn:     *dueOn,
+		DaysLate:  -days,
+	}
+	for _, person := range assignees {
+		item.Assignees = append(item.Assignees, person.Name)
+		item.assigneeEmails = append(item.assigneeEmails, person.EmailAddress)
+	}
+	return item, true
+}
+
+// filterDueItems keeps overdue items plus those due within withinDays (a
+// negative window keeps only overdue items), optionally limited to an assignee
+func filterDueItems(items []dueItem, withinDays int, assignee string) []dueItem {
+	assignee = strings.ToLower(assignee)
+	var filtered []dueItem
+	for _, item := range items {
+		if item.DaysLate <= 0 && -item.DaysLate > withinDays {
+			continue
+		}
+		if assignee != "" && !dueItemAssignedTo(item, assignee) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+	return filtered
+}
+
+func dueItemAssignedTo(item dueItem, assignee string) bool {
+	for i, name := range item.Assignees {
+		if strings.Contains(strings.ToLower(name), assignee) ||
+			strings.EqualFold(item.assigneeEmails[i], assignee) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortDueItems orders items with the most overdue first
+func sortDueItems(items []dueItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.DaysLate != b.DaysLate {
+			return a.DaysLate > b.DaysLate
+		}
+		if a.Project != b.Project {
+			return strings.ToLower(a.Project) < strings.ToLower(b.Project)
+		}
+		return strings.ToLower(a.Title) < strings.ToLower(b.Title)
+	})
+}
+
+// dueLabel describes how late or how soon an item is due
+func dueLabel(daysLate int) string {
+	switch {
+	case daysLate > 0:
+		return fmt.Sprintf("%dd late", daysLate)
+	case daysLate == 0:
+		return "today"
+	case daysLate == -1:
+		return "tomorrow"
+	default:
+		return fmt.Sprintf("in %dd", -daysLate)
+	}
+}
+
+func renderDueItems(items []dueItem, projectCount int, loc *time.Location) error {
+	isTTY := ui.IsTerminal(os.Stdout)
+	if len(items) == 0 {
+		if isTTY {
+			fmt.Printf("Nothing overdue or due soon in %d project(s). 🎉\n", projectCount)
+		}
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+	cs := table.GetColorScheme()
+
+	if isTTY {
+		table.AddHeader("DUE", "", "TITLE", "PROJECT", "LIST", "ASSIGNEE")
+	} else {
+		table.AddHeader("DUE_ON", "DAYS_LATE", "TYPE", "ID", "TITLE", "PROJECT", "LIST", "ASSIGNEE")
+	}
+
+	for _, item := range items {
+		assignees := strings.Join(item.Assignees, ", ")
+		if !isTTY {
+			table.AddField(item.DueOn)
+			table.AddField(strconv.Itoa(item.DaysLate))
+			table.AddField(item.Type)
+			table.AddField(strconv.FormatInt(item.ID, 10))
+			table.AddField(item.Title)
+			table.AddField(item.Project)
+			table.AddField(item.List)
+			table.AddField(assignees)
+			table.EndRow()
+			continue
+		}
+
+		lateColor := cs.Muted
+		switch {
+		case item.DaysLate > 0:
+			lateColor = cs.Red
+		case item.DaysLate == 0:
+			lateColor = cs.Yellow
+		}
+		due := item.DueOn
+		if t, ok := utils.ParseDueDate(&item.DueOn, loc); ok {
+			due = t.Format("Jan 2")
+		}
+		title := item.Title
+		if item.Type == "card" {
+			title = "▪ " + title
+		}
+
+		table.AddField(due)
+		table.AddField(dueLabel(item.DaysLate), lateColor)
+		table.AddField(title)
+		table.AddField(item.Project, cs.Bold)
+		table.AddField(item.List, cs.Muted)
+		table.AddField(assignees)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+func writeDueItemsCSV(items []dueItem) error {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"due_on", "days_late", "type", "id", "title", "project_id", "project", "list", "assignees"}); err != nil {
+		return err
+	}
+	for _, item := range items {
+		record := []string{
+			item.DueOn,
+			strconv.Itoa(item.DaysLate),
+			item.Type,
+			strconv.FormatInt(item.ID, 10),
+			item.Title,
+			strconv.FormatInt(item.ProjectID, 10),
+			item.Project,
+			item.List,
+			strings.Join(item.Assignees, "; "),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	return writer.Error()
+}
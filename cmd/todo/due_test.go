@@ -0,0 +1,153 @@
+package todo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	bcerrors "github.com/needmore/bc4/internal/errors"
+)
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{"7", 7, false},
+		{"3d", 3, false},
+		{"2w", 14, false},
+		{" 10D ", 10, false},
+		{"0d", 0, false},
+		{"soon", 0, true},
+		{"-1d", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseDays(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScanProjectDue(t *testing.T) {
+	today := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	due := func(s string) *string { return &s }
+
+	client := mock.NewMockClient()
+	client.TodoSet = &api.TodoSet{ID: 1}
+	client.TodoLists = []api.TodoList{
+		{ID: 10, Title: "Launch"},
+		{ID: 11, Title: "Archive", Completed: true},
+	}
+	client.Todos = []api.Todo{
+		{ID: 100, Title: "Late", DueOn: due("2024-05-12"), Assignees: []api.Person{{Name: "Jane Smith", EmailAddress: "jane@example.com"}}},
+		{ID: 101, Title: "No date"},
+		{ID: 102, Title: "Done", DueOn: due("2024-05-01"), Completed: true},
+	}
+	client.CardTable = &api.CardTable{
+		Title: "Board",
+		Lists: []api.Column{
+			{ID: 20, Title: "Doing", Type: "Kanban::Column"},
+			{ID: 21, Title: "Done", Type: doneColumnType},
+		},
+	}
+	client.Cards = []api.Card{{ID: 200, Title: "Card", DueOn: due("2024-05-20")}}
+
+	project := api.Project{ID: 5, Name: "Website"}
+	items, err := scanProjectDue(context.Background(), client, client, project, today)
+	require.NoError(t, err)
+
+	require.Len(t, items, 2)
+	assert.Equal(t, "todo", items[0].Type)
+	assert.Equal(t, int64(100), items[0].ID)
+	assert.Equal(t, 3, items[0].DaysLate)
+	assert.Equal(t, "Launch", items[0].List)
+	assert.Equal(t, "Website", items[0].Project)
+	assert.Equal(t, []string{"Jane Smith"}, items[0].Assignees)
+
+	assert.Equal(t, "card", items[1].Type)
+	assert.Equal(t, -5, items[1].DaysLate)
+	assert.Equal(t, "Board › Doing", items[1].List)
+
+	assert.NotContains(t, client.Calls, "GetTodos(5, 11)")
+	assert.NotContains(t, client.Calls, "GetCardsInColumn(5, 21)")
+
+	// Without card operations only todos are scanned
+	items, err = scanProjectDue(context.Background(), client, nil, project, today)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+}
+
+func TestScanProjectDueCardTableErrors(t *testing.T) {
+	today := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	late := "2024-05-12"
+	project := api.Project{ID: 5, Name: "Website"}
+
+	client := mock.NewMockClient()
+	client.TodoSet = &api.TodoSet{ID: 1}
+	client.TodoLists = []api.TodoList{{ID: 10, Title: "Launch"}}
+	client.Todos = []api.Todo{{ID: 100, Title: "Late", DueOn: &late}}
+
+	// A project without a card table is just todos
+	client.CardTableError = api.ErrNoCardTables
+	items, err := scanProjectDue(context.Background(), client, client, project, today)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	client.CardTableError = bcerrors.NewNotFoundError("card table", "", nil)
+	_, err = scanProjectDue(context.Background(), client, client, project, today)
+	require.NoError(t, err)
+
+	// Anything else is a failed scan, keeping the todos found so far
+	client.CardTableError = bcerrors.NewAPIError(429, "rate limited", nil)
+	items, err = scanProjectDue(context.Background(), client, client, project, today)
+	assert.ErrorContains(t, err, "failed to fetch card tables")
+	assert.Len(t, items, 1)
+}
+
+func TestFilterAndSortDueItems(t *testing.T) {
+	items := []dueItem{
+		{ID: 1, DaysLate: -3, Project: "B"},
+		{ID: 2, DaysLate: 5, Project: "A", Assignees: []string{"Jane Smith"}, assigneeEmails: []string{"jane@example.com"}},
+		{ID: 3, DaysLate: -10, Project: "A"},
+		{ID: 4, DaysLate: 0, Project: "A"},
+		{ID: 5, DaysLate: 5, Project: "0-first"},
+	}
+
+	ids := func(items []dueItem) []int64 {
+		var out []int64
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return out
+	}
+
+	within := filterDueItems(items, 7, "")
+	sortDueItems(within)
+	assert.Equal(t, []int64{5, 2, 4, 1}, ids(within))
+
+	overdue := filterDueItems(items, -1, "")
+	assert.Equal(t, []int64{2, 5}, ids(overdue))
+
+	assert.Equal(t, []int64{2}, ids(filterDueItems(items, 7, "jane")))
+	assert.Equal(t, []int64{2}, ids(filterDueItems(items, 7, "jane@example.com")))
+}
+
+func TestDueLabel(t *testing.T) {
+	assert.Equal(t, "3d late", dueLabel(3))
+	assert.Equal(t, "today", dueLabel(0))
+	assert.Equal(t, "tomorrow", dueLabel(-1))
+	assert.Equal(t, "in 4d", dueLabel(-4))
+}
@@ -5,15 +5,14 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/attachments"
 	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/markdown"
-	"github.com/needmore/bc4/internal/parser"
 	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
@@ -28,25 +27,39 @@ type editOptions struct {
 	file        string
 	clearDue    bool
 	attach      []string
+	editor      bool
+	filter      todoFilter
 }
 
 func newEditCmd(f *factory.Factory) *cobra.Command {
 	opts := &editOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "edit <todo-id|url>",
-		Short: "Edit an existing todo",
+		Use:   "edit <todo-id|url>... | - | --list <list>",
+		Short: "Edit one or more existing todos",
 		Long: `Edit an existing todo's title, description, due date, or assignees.
 
-You can specify the todo using either:
-- A numeric ID (e.g., "12345")
-- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
+You can specify todos using either:
+- Numeric IDs (e.g., "12345")
+- Basecamp URLs (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
+- "-" to read newline-separated IDs or URLs from stdin
+
+Or select incomplete todos in the current project with --list, --assignee
+and --due-before. The same changes are applied to every selected todo; each
+result is printed followed by a summary, and the command exits non-zero if
+any failed.
 
 All fields are optional - only specified fields will be updated.
 
 Use --attach to add images or files to the todo description. Attachments are
 appended to the existing description. Multiple files can be attached by using
-the flag multiple times.`,
+the flag multiple times.
+
+Use --editor to edit a single todo in your editor (preferences.editor,
+$VISUAL or $EDITOR). The file holds YAML front matter with the title, due
+and start dates, assignees, list and group, followed by the description as
+Markdown. Only the fields you change are applied, and quitting without
+saving changes aborts the edit.`,
 		Example: `  # Edit todo title
   bc4 todo edit 12345 --title "Updated title"
 
@@ -68,6 +81,9 @@ the flag multiple times.`,
   # Update from a markdown file
   bc4 todo edit 12345 --file updated-todo.md
 
+  # Edit the title, dates, assignees and description in your editor
+  bc4 todo edit 12345 --editor
+
   # Edit using a URL
   bc4 todo edit https://3.basecamp.com/.../todos/12345 --title "New title"
 
@@ -75,8 +91,13 @@ the flag multiple times.`,
   bc4 todo edit 12345 --attach ./screenshot.png
 
   # Add multiple attachments
-  bc4 todo edit 12345 --attach ./photo1.jpg --attach ./photo2.jpg`,
-		Args: cmdutil.ExactArgs(1, "todo-id"),
+  bc4 todo edit 12345 --attach ./photo1.jpg --attach ./photo2.jpg
+
+  # Push several todos to a new date
+  bc4 todo edit 12345 12346 12347 --due 2025-03-01
+
+  # Hand over everything assigned to Jane in a list
+  bc4 todo edit --list "Sprint 1" --assignee jane@example.com --assign bob@example.com --unassign jane@example.com`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runEdit(f, opts, args)
 		},
@@ -84,35 +105,23 @@ the flag multiple times.`,
 
 	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "New title for the todo")
 	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "New description for the todo")
-	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD)")
-	cmd.Flags().StringVar(&opts.startsOn, "starts-on", "", "Start date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.due, "due", "", "Due date (YYYY-MM-DD, or e.g. today, fri, next monday, +3d)")
+	cmd.Flags().StringVar(&opts.startsOn, "starts-on", "", "Start date (YYYY-MM-DD, or e.g. today, fri, next monday, +3d)")
 	cmd.Flags().StringSliceVar(&opts.assign, "assign", nil, "Add assignees (by email or name)")
 	cmd.Flags().StringSliceVar(&opts.unassign, "unassign", nil, "Remove assignees (by email or name)")
 	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "Read new content from a markdown file")
 	cmd.Flags().BoolVar(&opts.clearDue, "clear-due", false, "Clear the due date")
 	cmd.Flags().StringSliceVar(&opts.attach, "attach", nil, "Attach file(s) to the todo (can be used multiple times)")
+	cmd.Flags().BoolVarP(&opts.editor, "editor", "e", false, "Edit the todo in your editor with YAML front matter")
+	opts.filter.addFlags(cmd)
 
 	return cmd
 }
 
 func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
-	// Parse todo ID (could be numeric ID or URL)
-	todoID, parsedURL, err := parser.ParseArgument(args[0])
+	f, targets, err := collectTodoTargets(f, args, &opts.filter, false, "", "")
 	if err != nil {
-		return fmt.Errorf("invalid todo ID or URL: %s", args[0])
-	}
-
-	// If a URL was parsed, override account and project IDs if provided
-	if parsedURL != nil {
-		if parsedURL.ResourceType != parser.ResourceTypeTodo {
-			return fmt.Errorf("URL is not for a todo: %s", args[0])
-		}
-		if parsedURL.AccountID > 0 {
-			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
-		}
-		if parsedURL.ProjectID > 0 {
-			f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
-		}
+		return err
 	}
 
 	// Get API client from factory
@@ -122,16 +131,17 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 	}
 	todoOps := client.Todos()
 
-	// Get resolved project ID
-	resolvedProjectID, err := f.ProjectID()
-	if err != nil {
-		return err
-	}
-
-	// Fetch the current todo to get existing values
-	currentTodo, err := todoOps.GetTodo(f.Context(), resolvedProjectID, todoID)
-	if err != nil {
-		return fmt.Errorf("failed to get todo: %w", err)
+	if opts.editor {
+		if len(targets) != 1 {
+			return fmt.Errorf("--editor edits a single todo")
+		}
+		if opts.title != "" || opts.description != "" || opts.due != "" || opts.startsOn != "" ||
+			len(opts.assign) > 0 || len(opts.unassign) > 0 || opts.file != "" || opts.clearDue || len(opts.attach) > 0 {
+			return fmt.Errorf("--editor cannot be combined with other changes")
+		}
+		return runBulk(targets, func(t todoTarget) (string, error) {
+			return editTodoInEditor(f, client, t)
+		})
 	}
 
 	// Handle file input
@@ -142,7 +152,7 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 			return fmt.Errorf("failed to read file: %w", err)
 		}
 		fileContent = strings.TrimSpace(string(data))
-	} else {
+	} else if !readsIDsFromStdin(args) {
 		// Check if stdin has data
 		stat, _ := os.Stdin.Stat()
 		if (stat.Mode() & os.ModeCharDevice) == 0 {
@@ -175,8 +185,8 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 		return fmt.Errorf("no changes specified. Use --title, --description, --due, --assign, --unassign, --attach, or --file to specify changes")
 	}
 
-	// Build update request
-	req := api.TodoUpdateRequest{}
+	// Build the part of the update request shared by every todo
+	base := api.TodoUpdateRequest{}
 
 	// Create markdown converter
 	converter := markdown.NewConverter()
@@ -187,7 +197,7 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to convert title: %w", err)
 		}
-		req.Content = richTitle
+		base.Content = richTitle
 	}
 
 	// Handle description update
@@ -196,112 +206,183 @@ func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to convert description: %w", err)
 		}
-		req.Description = richDescription
+		base.Description = richDescription
 	}
 
-	// Handle attachments - append to existing or new description
-	if len(opts.attach) > 0 {
-		// Start with the description we already have, or the current todo's description
-		baseDescription := req.Description
-		if baseDescription == "" {
-			baseDescription = currentTodo.Description
+	// Upload attachments once; the same tags are appended to every todo
+	var attachmentTags string
+	for _, attachPath := range opts.attach {
+		fileData, err := os.ReadFile(attachPath)
+		if err != nil {
+			return fmt.Errorf("failed to read attachment %s: %w", attachPath, err)
 		}
-
-		for _, attachPath := range opts.attach {
-			fileData, err := os.ReadFile(attachPath)
-			if err != nil {
-				return fmt.Errorf("failed to read attachment %s: %w", attachPath, err)
-			}
-			filename := filepath.Base(attachPath)
-			upload, err := client.UploadAttachment(filename, fileData, "")
-			if err != nil {
-				return fmt.Errorf("failed to upload attachment %s: %w", filename, err)
-			}
-			tag := attachments.BuildTag(upload.AttachableSGID)
-			baseDescription += tag
+		filename := filepath.Base(attachPath)
+		upload, err := client.UploadAttachment(filename, fileData, "")
+		if err != nil {
+			return fmt.Errorf("failed to upload attachment %s: %w", filename, err)
 		}
-		req.Description = baseDescription
+		attachmentTags += attachments.BuildTag(upload.AttachableSGID)
 	}
 
-	// Handle due date
+	// Handle due and start dates
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
 	if opts.clearDue {
 		emptyDate := ""
-		req.DueOn = &emptyDate
+		base.DueOn = &emptyDate
 	} else if opts.due != "" {
-		req.DueOn = &opts.due
+		due, err := cmdutil.ResolveDate("Due date", opts.due, loc)
+		if err != nil {
+			return err
+		}
+		base.DueOn = &due
 	}
-
-	// Handle start date
 	if opts.startsOn != "" {
-		req.StartsOn = &opts.startsOn
+		startsOn, err := cmdutil.ResolveDate("Start date", opts.startsOn, loc)
+		if err != nil {
+			return err
+		}
+		base.StartsOn = &startsOn
 	}
 
-	// Handle assignee changes
-	if len(opts.assign) > 0 || len(opts.unassign) > 0 {
-		// Create user resolver
-		userResolver := utils.NewUserResolver(client.Client, resolvedProjectID)
+	assignees := newAssigneeChanges(f, client, opts.assign, opts.unassign)
 
-		// Start with existing assignees
-		currentAssigneeIDs := make([]int64, 0)
-		for _, assignee := range currentTodo.Assignees {
-			currentAssigneeIDs = append(currentAssigneeIDs, assignee.ID)
+	return runBulk(targets, func(t todoTarget) (string, error) {
+		req := base
+
+		// Fetch the current todo to get existing values
+		currentTodo, err := todoOps.GetTodo(f.Context(), t.projectID, t.todoID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get todo: %w", err)
 		}
 
-		// Add new assignees
-		if len(opts.assign) > 0 {
-			newAssigneeIDs, err := userResolver.ResolveUsers(f.Context(), opts.assign)
-			if err != nil {
-				return fmt.Errorf("failed to resolve assignees to add: %w", err)
-			}
-			// Merge without duplicates
-			for _, newID := range newAssigneeIDs {
-				found := false
-				for _, existingID := range currentAssigneeIDs {
-					if existingID == newID {
-						found = true
-						break
-					}
-				}
-				if !found {
-					currentAssigneeIDs = append(currentAssigneeIDs, newID)
-				}
+		// Handle attachments - append to existing or new description
+		if attachmentTags != "" {
+			if req.Description == "" {
+				req.Description = currentTodo.Description
 			}
+			req.Description += attachmentTags
 		}
 
-		// Remove assignees
-		if len(opts.unassign) > 0 {
-			removeIDs, err := userResolver.ResolveUsers(f.Context(), opts.unassign)
+		// Handle assignee changes
+		if assignees.any() {
+			ids, err := assignees.apply(t.projectID, currentTodo.Assignees)
 			if err != nil {
-				return fmt.Errorf("failed to resolve assignees to remove: %w", err)
+				return "", err
 			}
-			// Filter out removed assignees
-			filteredIDs := make([]int64, 0)
-			for _, existingID := range currentAssigneeIDs {
-				shouldRemove := false
-				for _, removeID := range removeIDs {
-					if existingID == removeID {
-						shouldRemove = true
-						break
-					}
-				}
-				if !shouldRemove {
-					filteredIDs = append(filteredIDs, existingID)
-				}
-			}
-			currentAssigneeIDs = filteredIDs
+			req.AssigneeIDs = ids
 		}
 
-		req.AssigneeIDs = currentAssigneeIDs
+		// Update the todo
+		updatedTodo, err := todoOps.UpdateTodo(f.Context(), t.projectID, t.todoID, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to update todo: %w", err)
+		}
+
+		// Output the updated todo ID (GitHub CLI style - minimal output)
+		return fmt.Sprintf("Updated #%d", updatedTodo.ID), nil
+	})
+}
+
+// readsIDsFromStdin reports whether "-" was passed to read todo IDs from stdin
+func readsIDsFromStdin(args []string) bool {
+	for _, arg := range args {
+		if arg == "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// assigneeChanges adds and removes assignees, resolving names once per project
+type assigneeChanges struct {
+	f        *factory.Factory
+	client   *api.ModularClient
+	assign   []string
+	unassign []string
+
+	mu       sync.Mutex
+	resolved map[string][2][]int64
+}
+
+func newAssigneeChanges(f *factory.Factory, client *api.ModularClient, assign, unassign []string) *assigneeChanges {
+	return &assigneeChanges{
+		f:        f,
+		client:   client,
+		assign:   assign,
+		unassign: unassign,
+		resolved: make(map[string][2][]int64),
+	}
+}
+
+func (a *assigneeChanges) any() bool {
+	return len(a.assign) > 0 || len(a.unassign) > 0
+}
+
+// resolve returns the IDs to add and remove for a project
+func (a *assigneeChanges) resolve(projectID string) ([]int64, []int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if ids, ok := a.resolved[projectID]; ok {
+		return ids[0], ids[1], nil
+	}
+
+	// Create user resolver
+	userResolver := utils.NewUserResolver(a.client.Client, projectID)
+
+	var addIDs, removeIDs []int64
+	var err error
+	if len(a.assign) > 0 {
+		addIDs, err = userResolver.ResolveUsers(a.f.Context(), a.assign)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve assignees to add: %w", err)
+		}
+	}
+	if len(a.unassign) > 0 {
+		removeIDs, err = userResolver.ResolveUsers(a.f.Context(), a.unassign)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve assignees to remove: %w", err)
+		}
 	}
 
-	// Update the todo
-	updatedTodo, err := todoOps.UpdateTodo(f.Context(), resolvedProjectID, todoID, req)
+	a.resolved[projectID] = [2][]int64{addIDs, removeIDs}
+	return addIDs, removeIDs, nil
+}
+
+// apply returns the assignee IDs after adding and removing people
+func (a *assigneeChanges) apply(projectID string, current []api.Person) ([]int64, error) {
+	addIDs, removeIDs, err := a.resolve(projectID)
 	if err != nil {
-		return fmt.Errorf("failed to update todo: %w", err)
+		return nil, err
+	}
+	return mergeAssigneeIDs(current, addIDs, removeIDs), nil
+}
+
+// mergeAssigneeIDs starts from the current assignees, adds new IDs without
+// duplicates and filters out removed ones
+func mergeAssigneeIDs(current []api.Person, addIDs, removeIDs []int64) []int64 {
+	ids := make([]int64, 0, len(current)+len(addIDs))
+	seen := make(map[int64]bool)
+	removed := make(map[int64]bool, len(removeIDs))
+	for _, id := range removeIDs {
+		removed[id] = true
 	}
 
-	// Output the updated todo ID (GitHub CLI style - minimal output)
-	fmt.Printf("Updated #%d\n", updatedTodo.ID)
+	for _, person := range current {
+		if !seen[person.ID] && !removed[person.ID] {
+			seen[person.ID] = true
+			ids = append(ids, person.ID)
+		}
+	}
+	for _, id := range addIDs {
+		if !seen[id] && !removed[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
 
-	return nil
+	return ids
 }
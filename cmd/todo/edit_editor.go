@@ -0,0 +1,283 @@
+package todo
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// todoFrontMatter holds the todo fields edited above the description
+type todoFrontMatter struct {
+	Title     string   `yaml:"title"`
+	Due       string   `yaml:"due"`
+	Starts    string   `yaml:"starts"`
+	Assignees []string `yaml:"assignees"`
+	List      string   `yaml:"list"`
+	Group     string   `yaml:"group"`
+}
+
+// todoDocument is a todo as edited in $EDITOR: front matter followed by the
+// description as Markdown
+type todoDocument struct {
+	front       todoFrontMatter
+	description string
+}
+
+// todoDocumentChanges records which parts of a todo document were edited
+type todoDocumentChanges struct {
+	title       bool
+	description bool
+	due         bool
+	starts      bool
+	assignees   bool
+	location    bool
+}
+
+func (c todoDocumentChanges) any() bool {
+	return c.content() || c.location
+}
+
+// content reports whether the todo itself needs updating, as opposed to
+// moving it
+func (c todoDocumentChanges) content() bool {
+	return c.title || c.description || c.due || c.starts || c.assignees
+}
+
+// editTodoInEditor opens a single todo in the user's editor and applies
+// whatever was changed
+func editTodoInEditor(f *factory.Factory, client *api.ModularClient, t todoTarget) (string, error) {
+	if !ui.IsTerminal(os.Stdin) || !ui.IsTerminal(os.Stdout) {
+		return "", fmt.Errorf("--editor requires an interactive terminal")
+	}
+
+	ctx := f.Context()
+	todoOps := client.Todos()
+	converter := markdown.NewConverter()
+
+	todo, err := todoOps.GetTodo(ctx, t.projectID, t.todoID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get todo: %w", err)
+	}
+
+	// A todo's container is either a list or a group within one
+	container, err := todoOps.GetTodoList(ctx, t.projectID, todo.TodolistID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get todo list: %w", err)
+	}
+	before := todoDocument{}
+	if container.Parent != nil && container.Parent.Type == "Todolist" {
+		before.front.List = container.Parent.Title
+		before.front.Group = container.Title
+	} else {
+		before.front.List = container.Title
+	}
+
+	if before.front.Title, err = converter.RichTextToMarkdown(todo.Content); err != nil {
+		return "", fmt.Errorf("failed to convert title: %w", err)
+	}
+	if before.description, err = converter.RichTextToMarkdown(todo.Description); err != nil {
+		return "", fmt.Errorf("failed to convert description: %w", err)
+	}
+	before.front.Title = strings.TrimSpace(before.front.Title)
+	before.description = strings.TrimSpace(before.description)
+	if todo.DueOn != nil {
+		before.front.Due = *todo.DueOn
+	}
+	if todo.StartsOn != nil {
+		before.front.Starts = *todo.StartsOn
+	}
+	for _, person := range todo.Assignees {
+		if person.EmailAddress != "" {
+			before.front.Assignees = append(before.front.Assignees, person.EmailAddress)
+		} else {
+			before.front.Assignees = append(before.front.Assignees, person.Name)
+		}
+	}
+
+	original, err := renderTodoDocument(before)
+	if err != nil {
+		return "", err
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return "", err
+	}
+	edited, err := utils.EditInEditor(utils.ResolveEditor(cfg.Preferences.Editor), fmt.Sprintf("bc4-todo-%d-*.md", todo.ID), original)
+	if err != nil {
+		return "", err
+	}
+	if edited == original {
+		return "", fmt.Errorf("no changes made, edit aborted")
+	}
+
+	after, err := parseTodoDocument(edited)
+	if err != nil {
+		return "", err
+	}
+	changes := diffTodoDocuments(before, after)
+	if !changes.any() {
+		return "", fmt.Errorf("no changes made, edit aborted")
+	}
+
+	if changes.content() {
+		// Send every field so nothing is cleared by omission
+		req := api.TodoUpdateRequest{
+			Content:     todo.Content,
+			Description: todo.Description,
+			DueOn:       todo.DueOn,
+			StartsOn:    todo.StartsOn,
+			AssigneeIDs: mergeAssigneeIDs(todo.Assignees, nil, nil),
+		}
+		if changes.title {
+			if req.Content, err = converter.MarkdownToRichText(after.front.Title); err != nil {
+				return "", fmt.Errorf("failed to convert title: %w", err)
+			}
+		}
+		if changes.description {
+			if req.Description, err = converter.MarkdownToRichText(after.description); err != nil {
+				return "", fmt.Errorf("failed to convert description: %w", err)
+			}
+		}
+
+		loc, err := f.Location()
+		if err != nil {
+			return "", err
+		}
+		if changes.due {
+			due := ""
+			if after.front.Due != "" {
+				if due, err = cmdutil.ResolveDate("Due date", after.front.Due, loc); err != nil {
+					return "", err
+				}
+			}
+			req.DueOn = &due
+		}
+		if changes.starts {
+			startsOn := ""
+			if after.front.Starts != "" {
+				if startsOn, err = cmdutil.ResolveDate("Start date", after.front.Starts, loc); err != nil {
+					return "", err
+				}
+			}
+			req.StartsOn = &startsOn
+		}
+		if changes.assignees {
+			req.AssigneeIDs = nil
+			if len(after.front.Assignees) > 0 {
+				userResolver := utils.NewUserResolver(client.Client, t.projectID)
+				if req.AssigneeIDs, err = userResolver.ResolveUsers(ctx, after.front.Assignees); err != nil {
+					return "", fmt.Errorf("failed to resolve assignees: %w", err)
+				}
+			}
+		}
+
+		if _, err := todoOps.UpdateTodo(ctx, t.projectID, todo.ID, req); err != nil {
+			return "", fmt.Errorf("failed to update todo: %w", err)
+		}
+	}
+
+	if changes.location {
+		if after.front.List == "" {
+			return "", fmt.Errorf("list cannot be empty")
+		}
+		list, err := resolveTodoList(f, client, t.projectID, after.front.List)
+		if err != nil {
+			return "", err
+		}
+		parentID := list.ID
+		if after.front.Group != "" {
+			group, err := resolveTodoGroup(f, client, t.projectID, list.ID, after.front.Group)
+			if err != nil {
+				return "", err
+			}
+			parentID = group.ID
+		}
+		if err := todoOps.MoveTodo(ctx, t.projectID, todo.ID, parentID, 1); err != nil {
+			return "", fmt.Errorf("failed to move todo: %w", err)
+		}
+	}
+
+	return fmt.Sprintf("Updated #%d", todo.ID), nil
+}
+
+// renderTodoDocument formats a todo for editing
+func renderTodoDocument(doc todoDocument) (string, error) {
+	front, err := yaml.Marshal(doc.front)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode front matter: %w", err)
+	}
+	return utils.JoinFrontMatter(string(front), doc.description), nil
+}
+
+// parseTodoDocument reads back an edited todo document
+func parseTodoDocument(content string) (todoDocument, error) {
+	front, body, ok := utils.SplitFrontMatter(content)
+	if !ok {
+		return todoDocument{}, fmt.Errorf("missing front matter: the file must start with a block between --- lines")
+	}
+
+	var doc todoDocument
+	if err := yaml.Unmarshal([]byte(front), &doc.front); err != nil {
+		return todoDocument{}, fmt.Errorf("invalid front matter: %w", err)
+	}
+	doc.front.Title = strings.TrimSpace(doc.front.Title)
+	doc.front.Due = strings.TrimSpace(doc.front.Due)
+	doc.front.Starts = strings.TrimSpace(doc.front.Starts)
+	doc.front.List = strings.TrimSpace(doc.front.List)
+	doc.front.Group = strings.TrimSpace(doc.front.Group)
+	if doc.front.Title == "" {
+		return todoDocument{}, fmt.Errorf("title cannot be empty")
+	}
+	doc.description = strings.TrimSpace(body)
+
+	return doc, nil
+}
+
+// diffTodoDocuments compares the document as opened with the saved one
+func diffTodoDocuments(before, after todoDocument) todoDocumentChanges {
+	return todoDocumentChanges{
+		title:       before.front.Title != after.front.Title,
+		description: before.description != after.description,
+		due:         before.front.Due != after.front.Due,
+		starts:      before.front.Starts != after.front.Starts,
+		assignees:   !sameAssignees(before.front.Assignees, after.front.Assignees),
+		location: !strings.EqualFold(before.front.List, after.front.List) ||
+			!strings.EqualFold(before.front.Group, after.front.Group),
+	}
+}
+
+// sameAssignees compares assignee lists ignoring order and case
+func sameAssignees(a, b []string) bool {
+	normalize := func(names []string) []string {
+		out := make([]string, 0, len(names))
+		for _, name := range names {
+			if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+				out = append(out, name)
+			}
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	na, nb := normalize(a), normalize(b)
+	if len(na) != len(nb) {
+		return false
+	}
+	for i := range na {
+		if na[i] != nb[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package todo
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodoDocumentRoundTrip(t *testing.T) {
+	doc := todoDocument{
+		front: todoFrontMatter{
+			Title:     "Ship **release** notes",
+			Due:       "2025-03-01",
+			Assignees: []string{"jane@example.com"},
+			List:      "Launch",
+			Group:     "Docs",
+		},
+		description: "First paragraph\n\n- a list item",
+	}
+
+	content, err := renderTodoDocument(doc)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(content, "---\ntitle: "))
+
+	parsed, err := parseTodoDocument(content)
+	require.NoError(t, err)
+	assert.Equal(t, doc, parsed)
+	assert.False(t, diffTodoDocuments(doc, parsed).any())
+}
+
+func TestParseTodoDocument(t *testing.T) {
+	parsed, err := parseTodoDocument("---\ntitle: Fix bug\ndue: 2025-04-01\nstarts: fri\nassignees: [Jane, bob@example.com]\nlist: Sprint\n---\n\nDetails here\n")
+	require.NoError(t, err)
+	assert.Equal(t, "Fix bug", parsed.front.Title)
+	assert.Equal(t, "2025-04-01", parsed.front.Due)
+	assert.Equal(t, "fri", parsed.front.Starts)
+	assert.Equal(t, []string{"Jane", "bob@example.com"}, parsed.front.Assignees)
+	assert.Equal(t, "Details here", parsed.description)
+
+	_, err = parseTodoDocument("just a description")
+	assert.ErrorContains(t, err, "missing front matter")
+
+	_, err = parseTodoDocument("---\ntitle: \"\"\n---\n")
+	assert.ErrorContains(t, err, "title cannot be empty")
+
+	_, err = parseTodoDocument("---\ntitle: [unclosed\n---\n")
+	assert.ErrorContains(t, err, "invalid front matter")
+}
+
+func TestDiffTodoDocuments(t *testing.T) {
+	before := todoDocument{
+		front: todoFrontMatter{
+			Title:     "Task",
+			Due:       "2025-03-01",
+			Assignees: []string{"a@example.com", "b@example.com"},
+			List:      "Launch",
+		},
+		description: "Notes",
+	}
+
+	after := before
+	after.front.Assignees = []string{"B@example.com", "a@example.com"}
+	after.front.List = "launch"
+	assert.False(t, diffTodoDocuments(before, after).any(), "reordering assignees and recasing the list is not a change")
+
+	after = before
+	after.front.Due = ""
+	after.description = "New notes"
+	changes := diffTodoDocuments(before, after)
+	assert.Equal(t, todoDocumentChanges{due: true, description: true}, changes)
+	assert.True(t, changes.content())
+
+	after = before
+	after.front.Group = "Backend"
+	changes = diffTodoDocuments(before, after)
+	assert.Equal(t, todoDocumentChanges{location: true}, changes)
+	assert.False(t, changes.content())
+}
@@ -0,0 +1,333 @@
+package todo
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+type importOptions struct {
+	list    string
+	format  string
+	columns map[string]string
+	dryRun  bool
+}
+
+// importPlan describes what an import will create
+type importPlan struct {
+	newGroups []string
+	create    []checklist.Item
+	existing  []checklist.Item
+}
+
+func newImportCmd(f *factory.Factory) *cobra.Command {
+	opts := &importOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import todos from a Markdown checklist, CSV, or JSON file",
+		Long: `Create todos in a list from a file. Use "-" to read from stdin.
+
+Markdown checklists follow GitHub's task list syntax:
+  - Headings become groups
+  - "- [ ]" and "- [x]" items become incomplete and completed todos
+  - Indented lines below an item become its description
+  - @name in an item assigns it, and due:YYYY-MM-DD sets the due date
+
+CSV files need a header row. Columns named title, description, group,
+assignees, due and completed (or common variants such as "Task" or
+"Due date") are picked up automatically; use --map to point fields at other
+headers.
+
+JSON files hold an array of objects with title, description, group,
+assignees, due_on and completed fields.
+
+Importing is idempotent: todos whose title already exists in the same list
+or group are skipped, so a file can be re-imported after adding to it.`,
+		Example: `  # Import a checklist into the default list
+  bc4 todo import release.md
+
+  # Preview what would be created
+  bc4 todo import release.md --list "Release 2.0" --dry-run
+
+  # Import a spreadsheet export with custom headers
+  bc4 todo import tasks.csv --list Backlog --map title=Summary,assignees=Owner
+
+  # Import JSON from another tool
+  some-tool export --json | bc4 todo import - --input-format json`,
+		Args: cmdutil.ExactArgs(1, "file"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImport(f, opts, args[0])
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.list, "list", "l", "", "Todo list ID, name, or URL (defaults to selected list)")
+	cmd.Flags().StringVar(&opts.format, "input-format", "", "Input format: markdown, csv, or json (detected from the file extension)")
+	cmd.Flags().StringToStringVar(&opts.columns, "map", nil, "Map fields to CSV headers, e.g. title=Task,due=Deadline")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show what would be created without making changes")
+
+	return cmd
+}
+
+func runImport(f *factory.Factory, opts *importOptions, path string) error {
+	format := checklist.DetectFormat(path)
+	if opts.format != "" {
+		var err error
+		format, err = checklist.ParseFormat(opts.format)
+		if err != nil {
+			return err
+		}
+	}
+
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("failed to open file: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		r = file
+	}
+
+	items, err := checklist.Parse(r, format, opts.columns)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("no todos found in %s", path)
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	todoOps := client.Todos()
+	ctx := f.Context()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	list, err := resolveTodoListOrDefault(f, client, projectID, opts.list)
+	if err != nil {
+		return err
+	}
+
+	// Collect what's already in the list so re-running skips existing todos
+	groups, err := todoOps.GetTodoGroups(ctx, projectID, list.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch todo groups: %w", err)
+	}
+	groupIDs := map[string]int64{"": list.ID}
+	existingTitles := make(map[string]bool)
+	converter := markdown.NewConverter()
+
+	todos, err := todoOps.GetAllTodos(ctx, projectID, list.ID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch todos: %w", err)
+	}
+	for _, todo := range todos {
+		existingTitles[importKey("", existingTitle(todo))] = true
+	}
+	for _, group := range groups {
+		groupIDs[strings.ToLower(group.Title)] = group.ID
+		groupTodos, err := todoOps.GetAllTodos(ctx, projectID, group.ID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch todos: %w", err)
+		}
+		for _, todo := range groupTodos {
+			existingTitles[importKey(group.Title, existingTitle(todo))] = true
+		}
+	}
+
+	plan := planImport(converter, items, groupIDs, existingTitles)
+
+	// Resolve assignees up front so a typo doesn't leave a half-done import
+	userResolver := utils.NewUserResolver(client.Client, projectID)
+	assigneeIDs := make(map[string]int64)
+	for _, item := range plan.create {
+		for _, name := range item.Assignees {
+			if _, ok := assigneeIDs[name]; ok {
+				continue
+			}
+			ids, err := userResolver.ResolveUsers(ctx, []string{name})
+			if err != nil {
+				return fmt.Errorf("failed to resolve assignees: %w", err)
+			}
+			assigneeIDs[name] = ids[0]
+		}
+	}
+
+	if opts.dryRun {
+		printImportPlan(plan, list.Title)
+		return nil
+	}
+
+	for _, name := range plan.newGroups {
+		group, err := todoOps.CreateTodoGroup(ctx, projectID, list.ID, api.TodoGroupCreateRequest{Name: name})
+		if err != nil {
+			return err
+		}
+		groupIDs[strings.ToLower(name)] = group.ID
+	}
+
+	tty := ui.IsTerminal(os.Stdout)
+	for _, item := range plan.create {
+		richTitle, err := converter.MarkdownToRichText(item.Title)
+		if err != nil {
+			return fmt.Errorf("failed to convert title: %w", err)
+		}
+		req := api.TodoCreateRequest{Content: richTitle}
+		if item.Description != "" {
+			req.Description, err = converter.MarkdownToRichText(item.Description)
+			if err != nil {
+				return fmt.Errorf("failed to convert description: %w", err)
+			}
+		}
+		if item.DueOn != "" {
+			due := item.DueOn
+			req.DueOn = &due
+		}
+		for _, name := range item.Assignees {
+			req.AssigneeIDs = append(req.AssigneeIDs, assigneeIDs[name])
+		}
+
+		todo, err := todoOps.CreateTodo(ctx, projectID, groupIDs[strings.ToLower(item.Group)], req)
+		if err != nil {
+			return fmt.Errorf("failed to create todo %q: %w", item.Title, err)
+		}
+		if item.Completed {
+			if err := todoOps.CompleteTodo(ctx, projectID, todo.ID); err != nil {
+				return fmt.Errorf("failed to complete todo #%d: %w", todo.ID, err)
+			}
+		}
+
+		if !tty {
+			fmt.Println(todo.ID)
+		}
+	}
+
+	if tty {
+		fmt.Printf("✓ Imported %d todos into %s", len(plan.create), list.Title)
+		if len(plan.newGroups) > 0 {
+			fmt.Printf(" (%d new groups)", len(plan.newGroups))
+		}
+		fmt.Println()
+		if len(plan.existing) > 0 {
+			fmt.Printf("  Skipped %d todos that already exist\n", len(plan.existing))
+		}
+	}
+
+	return nil
+}
+
+// planImport works out which groups and todos need creating. groupIDs holds
+// the existing groups keyed by lowercased title, and existingTitles the
+// todos already present keyed by importKey.
+func planImport(converter markdown.Converter, items []checklist.Item, groupIDs map[string]int64, existingTitles map[string]bool) *importPlan {
+	plan := &importPlan{}
+
+	planned := make(map[string]bool)
+	for _, group := range checklist.Groups(items) {
+		key := strings.ToLower(group)
+		if _, ok := groupIDs[key]; !ok && !planned[key] {
+			planned[key] = true
+			plan.newGroups = append(plan.newGroups, group)
+		}
+	}
+
+	seen := make(map[string]bool, len(existingTitles))
+	for key := range existingTitles {
+		seen[key] = true
+	}
+	for _, item := range items {
+		key := importKey(item.Group, importTitle(converter, item.Title))
+		if seen[key] {
+			plan.existing = append(plan.existing, item)
+			continue
+		}
+		seen[key] = true
+		plan.create = append(plan.create, item)
+	}
+
+	return plan
+}
+
+// importKey identifies a todo title within a group for duplicate detection.
+// Titles are compared as plain text, see importTitle and existingTitle.
+func importKey(group, title string) string {
+	return strings.ToLower(strings.TrimSpace(group)) + "\x00" + strings.ToLower(strings.TrimSpace(title))
+}
+
+// importTitle reduces a Markdown title to plain text the way it ends up in
+// Basecamp, so "**Ship** it" matches an existing "Ship it"
+func importTitle(converter markdown.Converter, title string) string {
+	richText, err := converter.MarkdownToRichText(title)
+	if err != nil {
+		return title
+	}
+	return richTextToPlain(richText)
+}
+
+// existingTitle returns the plain text of a todo's rich text title
+func existingTitle(todo api.Todo) string {
+	if todo.Content == "" {
+		return todo.Title
+	}
+	return richTextToPlain(todo.Content)
+}
+
+var htmlTag = regexp.MustCompile(`<[^>]*>`)
+
+// richTextToPlain strips tags and entities and collapses whitespace
+func richTextToPlain(richText string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(htmlTag.ReplaceAllString(richText, ""))), " ")
+}
+
+func printImportPlan(plan *importPlan, listName string) {
+	fmt.Printf("Would import into %s:\n", listName)
+	for _, group := range plan.newGroups {
+		fmt.Printf("  + group %q\n", group)
+	}
+	for _, item := range plan.create {
+		fmt.Printf("  + todo %q%s\n", item.Title, describeImportItem(item))
+	}
+	for _, item := range plan.existing {
+		fmt.Printf("  = todo %q (already exists)\n", item.Title)
+	}
+	fmt.Printf("\n%d to create, %d already exist\n", len(plan.create), len(plan.existing))
+}
+
+func describeImportItem(item checklist.Item) string {
+	var parts []string
+	if item.Group != "" {
+		parts = append(parts, "in "+item.Group)
+	}
+	if item.DueOn != "" {
+		parts = append(parts, "due "+item.DueOn)
+	}
+	if len(item.Assignees) > 0 {
+		parts = append(parts, strings.Join(item.Assignees, ", "))
+	}
+	if item.Completed {
+		parts = append(parts, "completed")
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, "; ") + ")"
+}
@@ -0,0 +1,56 @@
+package todo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+func TestPlanImport(t *testing.T) {
+	items := []checklist.Item{
+		{Title: "Existing"},
+		{Title: "New"},
+		{Title: "existing", Group: "Backend"},
+		{Title: "Deploy", Group: "Ops"},
+		{Title: "Deploy", Group: "ops"},
+	}
+	groupIDs := map[string]int64{"": 1, "backend": 2}
+	existing := map[string]bool{importKey("", "EXISTING"): true}
+
+	plan := planImport(markdown.NewConverter(), items, groupIDs, existing)
+
+	assert.Equal(t, []string{"Ops"}, plan.newGroups)
+	assert.Equal(t, []checklist.Item{
+		{Title: "New"},
+		{Title: "existing", Group: "Backend"},
+		{Title: "Deploy", Group: "Ops"},
+	}, plan.create)
+	assert.Equal(t, []checklist.Item{
+		{Title: "Existing"},
+		{Title: "Deploy", Group: "ops"},
+	}, plan.existing)
+}
+
+func TestPlanImportFormattedTitles(t *testing.T) {
+	items := []checklist.Item{
+		{Title: "**Ship** the `v2` [release](https://example.com)"},
+		{Title: "Fix *R&D* budget"},
+		{Title: "**New** one"},
+	}
+	existing := map[string]bool{}
+	for _, todo := range []api.Todo{
+		{Title: "Ship the v2 release", Content: `<strong>Ship</strong> the <code>v2</code> <a href="https://example.com">release</a>`},
+		{Title: "Fix R&D budget"},
+	} {
+		existing[importKey("", existingTitle(todo))] = true
+	}
+
+	plan := planImport(markdown.NewConverter(), items, map[string]int64{"": 1}, existing)
+
+	assert.Equal(t, []checklist.Item{{Title: "**New** one"}}, plan.create)
+	assert.Equal(t, items[:2], plan.existing)
+}
@@ -0,0 +1,380 @@
+package todo
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+type mineOptions struct {
+	accountID   string
+	person      string
+	formatStr   string
+	interactive bool
+}
+
+// workItem is an assignment annotated with its due bucket
+type workItem struct {
+	api.Assignment
+	DueBucket    string `json:"due_bucket"`
+	DaysUntilDue *int   `json:"days_until_due,omitempty"`
+
+	bucket utils.DueBucket
+}
+
+func newMineCmd(f *factory.Factory) *cobra.Command {
+	return newWorkCmd(f, "mine", "bc4 todo mine")
+}
+
+// NewMyWorkCmd creates the "my work" command, which shares its implementation
+// with "todo mine"
+func NewMyWorkCmd(f *factory.Factory) *cobra.Command {
+	return newWorkCmd(f, "work", "bc4 my work")
+}
+
+func newWorkCmd(f *factory.Factory, use, invocation string) *cobra.Command {
+	opts := &mineOptions{}
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: "Show open todos and card steps assigned to you across all projects",
+		Long: `Show every incomplete todo and card step assigned to you (or to --person)
+across all projects in the account, grouped by when it is due:
+Overdue, Today, This week, Later and No date.
+
+Basecamp only reports card steps for the signed-in user, so --person
+shows todos only.
+
+Use --interactive to pick items from the list and mark them complete.`,
+		Example: fmt.Sprintf(`  # Everything on your plate
+  %[1]s
+
+  # What a teammate is working on
+  %[1]s --person "jane@example.com"
+
+  # Export for a spreadsheet or script
+  %[1]s --format csv
+  %[1]s --format json
+
+  # Pick items to check off
+  %[1]s --interactive`, invocation),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.accountID != "" {
+				f = f.WithAccount(opts.accountID)
+			}
+			return runMine(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVar(&opts.person, "person", "", "Show work assigned to this person (name or email) instead of you")
+	cmd.Flags().StringVarP(&opts.formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().BoolVarP(&opts.interactive, "interactive", "i", false, "Select items to mark complete")
+
+	return cmd
+}
+
+func runMine(f *factory.Factory, opts *mineOptions) error {
+	format, err := ui.ParseOutputFormat(opts.formatStr)
+	if err != nil {
+		return err
+	}
+	if opts.interactive && !ui.IsTerminal(os.Stdout) {
+		return fmt.Errorf("--interactive requires a terminal")
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+
+	var assignments []api.Assignment
+	who := "you"
+	if opts.person == "" || strings.EqualFold(opts.person, "me") {
+		mine, err := client.Assignments().GetMyAssignments(f.Context())
+		if err != nil {
+			return err
+		}
+		assignments = mine.All()
+	} else {
+		// An empty project ID resolves against everyone in the account
+		resolver := utils.NewUserResolver(client.Client, "")
+		people, err := resolver.ResolvePeople(f.Context(), []string{opts.person})
+		if err != nil {
+			return err
+		}
+		who = people[0].Name
+		fmt.Fprintln(os.Stderr, "Warning: card steps assigned to other people aren't available from Basecamp; showing todos only")
+		assignments, err = client.Assignments().GetPersonAssignments(f.Context(), people[0].ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+	items := buildWorkItems(assignments, time.Now().In(loc))
+
+	if opts.interactive {
+		return completeWorkItems(f, client, items, loc)
+	}
+
+	switch format {
+	case ui.OutputFormatJSON:
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(items)
+	case ui.OutputFormatCSV:
+		return writeWorkItemsCSV(items)
+	}
+
+	if len(items) == 0 {
+		if ui.IsTerminal(os.Stdout) {
+			fmt.Printf("Nothing assigned to %s. 🎉\n", who)
+		}
+		return nil
+	}
+
+	return renderWorkItems(items, loc)
+}
+
+// buildWorkItems drops completed assignments, assigns due buckets and sorts
+// the result by bucket, due date and project
+func buildWorkItems(assignments []api.Assignment, today time.Time) []workItem {
+	items := make([]workItem, 0, len(assignments))
+	for _, a := range assignments {
+		if a.Completed {
+			continue
+		}
+		bucket := utils.DueBucketFor(a.DueOn, today)
+		item := workItem{Assignment: a, DueBucket: bucket.Slug(), bucket: bucket}
+		if days, ok := utils.DaysUntilDue(a.DueOn, today); ok {
+			item.DaysUntilDue = &days
+		}
+		items = append(items, item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.bucket != b.bucket {
+			return a.bucket < b.bucket
+		}
+		if a.DaysUntilDue != nil && b.DaysUntilDue != nil && *a.DaysUntilDue != *b.DaysUntilDue {
+			return *a.DaysUntilDue < *b.DaysUntilDue
+		}
+		return strings.ToLower(a.Bucket.Name) < strings.ToLower(b.Bucket.Name)
+	})
+
+	return items
+}
+
+// workItemKind returns a short label for the assignment type
+func workItemKind(a api.Assignment) string {
+	if a.Type == api.AssignmentTypeCardStep {
+		return "step"
+	}
+	return "todo"
+}
+
+// workItemParent returns the list or card an assignment belongs to
+func workItemParent(a api.Assignment) string {
+	if a.Parent == nil {
+		return ""
+	}
+	return a.Parent.Title
+}
+
+func workItemDue(a api.Assignment) string {
+	if a.DueOn == nil {
+		return ""
+	}
+	return *a.DueOn
+}
+
+func renderWorkItems(items []workItem, loc *time.Location) error {
+	isTTY := ui.IsTerminal(os.Stdout)
+	headingStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("99"))
+
+	if !isTTY {
+		table := tableprinter.New(os.Stdout)
+		table.AddHeader("BUCKET", "ID", "TYPE", "TITLE", "PROJECT", "LIST", "DUE")
+		for _, item := range items {
+			table.AddField(item.DueBucket)
+			table.AddField(strconv.FormatInt(item.ID, 10))
+			table.AddField(workItemKind(item.Assignment))
+			table.AddField(item.Content)
+			table.AddField(item.Bucket.Name)
+			table.AddField(workItemParent(item.Assignment))
+			table.AddField(workItemDue(item.Assignment))
+			table.EndRow()
+		}
+		return table.Render()
+	}
+
+	first := true
+	for _, bucket := range utils.DueBuckets {
+		var group []workItem
+		for _, item := range items {
+			if item.bucket == bucket {
+				group = append(group, item)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		if !first {
+			fmt.Println()
+		}
+		first = false
+		fmt.Println(headingStyle.Render(fmt.Sprintf("%s (%d)", bucket, len(group))))
+
+		table := tableprinter.New(os.Stdout)
+		cs := table.GetColorScheme()
+		table.AddHeader("ID", "TITLE", "PROJECT", "LIST", "DUE")
+		for _, item := range group {
+			state := "active"
+			if bucket == utils.DueOverdue {
+				state = "warning"
+			}
+			table.AddIDField(strconv.FormatInt(item.ID, 10), state)
+			title := item.Content
+			if item.Type == api.AssignmentTypeCardStep {
+				title = "◦ " + title
+			}
+			table.AddField(title)
+			table.AddField(item.Bucket.Name, cs.Bold)
+			table.AddField(workItemParent(item.Assignment), cs.Muted)
+			dueColor := cs.Muted
+			switch bucket {
+			case utils.DueOverdue:
+				dueColor = cs.Red
+			case utils.DueToday:
+				dueColor = cs.Yellow
+			}
+			table.AddField(formatDueLabel(item, loc), dueColor)
+			table.EndRow()
+		}
+		if err := table.Render(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatDueLabel renders a due date with a relative hint
+func formatDueLabel(item workItem, loc *time.Location) string {
+	if item.DaysUntilDue == nil {
+		return ""
+	}
+	due := workItemDue(item.Assignment)
+	if t, ok := utils.ParseDueDate(item.DueOn, loc); ok {
+		due = t.Format("Jan 2")
+	}
+	switch days := *item.DaysUntilDue; {
+	case days < 0:
+		return fmt.Sprintf("%s (%dd late)", due, -days)
+	case days == 0:
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	default:
+		return due
+	}
+}
+
+func writeWorkItemsCSV(items []workItem) error {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"bucket", "id", "type", "title", "project_id", "project", "list", "due_on", "url"}); err != nil {
+		return err
+	}
+	for _, item := range items {
+		record := []string{
+			item.DueBucket,
+			strconv.FormatInt(item.ID, 10),
+			workItemKind(item.Assignment),
+			item.Content,
+			strconv.FormatInt(item.Bucket.ID, 10),
+			item.Bucket.Name,
+			workItemParent(item.Assignment),
+			workItemDue(item.Assignment),
+			item.AppURL,
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	return writer.Error()
+}
+
+// completeWorkItems lets the user pick items from a list and marks them complete
+func completeWorkItems(f *factory.Factory, client *api.ModularClient, items []workItem, loc *time.Location) error {
+	if len(items) == 0 {
+		fmt.Println("Nothing to complete. 🎉")
+		return nil
+	}
+
+	options := make([]huh.Option[int], 0, len(items))
+	for i, item := range items {
+		label := fmt.Sprintf("[%s] %s — %s", item.bucket, item.Content, item.Bucket.Name)
+		if due := formatDueLabel(item, loc); due != "" {
+			label += " (" + due + ")"
+		}
+		options = append(options, huh.NewOption(label, i))
+	}
+
+	var selected []int
+	if err := huh.NewMultiSelect[int]().
+		Title("Select items to mark complete").
+		Options(options...).
+		Value(&selected).
+		Run(); err != nil {
+		return err
+	}
+
+	var failed int
+	for _, i := range selected {
+		item := items[i]
+		projectID := strconv.FormatInt(item.Bucket.ID, 10)
+
+		var err error
+		if item.Type == api.AssignmentTypeCardStep {
+			err = client.Steps().SetStepCompletion(f.Context(), projectID, item.ID, true)
+		} else {
+			err = client.Todos().CompleteTodo(f.Context(), projectID, item.ID)
+		}
+		if err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "✗ Failed to complete #%d: %v\n", item.ID, err)
+			continue
+		}
+		fmt.Printf("✓ Completed #%d: %s\n", item.ID, item.Content)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to complete %d of %d items", failed, len(selected))
+	}
+	return nil
+}
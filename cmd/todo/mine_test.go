@@ -0,0 +1,55 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+func TestBuildWorkItems(t *testing.T) {
+	today := time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	due := func(s string) *string { return &s }
+
+	assignments := []api.Assignment{
+		{ID: 1, Content: "Later task", DueOn: due("2024-06-01"), Bucket: api.Bucket{Name: "Beta"}},
+		{ID: 2, Content: "Undated", Bucket: api.Bucket{Name: "Alpha"}},
+		{ID: 3, Content: "Done already", DueOn: due("2024-05-01"), Completed: true},
+		{ID: 4, Content: "Very late", DueOn: due("2024-05-01"), Bucket: api.Bucket{Name: "Beta"}},
+		{ID: 5, Content: "A bit late", DueOn: due("2024-05-14"), Bucket: api.Bucket{Name: "Alpha"}},
+		{ID: 6, Content: "Step today", Type: api.AssignmentTypeCardStep, DueOn: due("2024-05-15")},
+		{ID: 7, Content: "Friday", DueOn: due("2024-05-17")},
+	}
+
+	items := buildWorkItems(assignments, today)
+
+	var ids []int64
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	assert.Equal(t, []int64{4, 5, 6, 7, 1, 2}, ids)
+
+	assert.Equal(t, utils.DueOverdue, items[0].bucket)
+	assert.Equal(t, "overdue", items[0].DueBucket)
+	assert.Equal(t, -14, *items[0].DaysUntilDue)
+	assert.Equal(t, "step", workItemKind(items[2].Assignment))
+	assert.Equal(t, "this_week", items[3].DueBucket)
+	assert.Nil(t, items[5].DaysUntilDue)
+	assert.Equal(t, "no_date", items[5].DueBucket)
+}
+
+func TestFormatDueLabel(t *testing.T) {
+	days := func(n int) *int { return &n }
+	due := "2024-05-10"
+
+	assert.Equal(t, "", formatDueLabel(workItem{}, time.UTC))
+	assert.Equal(t, "today", formatDueLabel(workItem{DaysUntilDue: days(0)}, time.UTC))
+	assert.Equal(t, "tomorrow", formatDueLabel(workItem{DaysUntilDue: days(1)}, time.UTC))
+	assert.Equal(t, "May 10 (5d late)", formatDueLabel(workItem{
+		Assignment:   api.Assignment{DueOn: &due},
+		DaysUntilDue: days(-5),
+	}, time.UTC))
+}
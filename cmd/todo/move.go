@@ -2,18 +2,28 @@ package todo
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 
+	"github.com/charmbracelet/huh"
+
+	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/cmdutil"
 	"github.com/needmore/bc4/internal/factory"
 	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 type moveOptions struct {
-	position int
-	top      bool
-	bottom   bool
+	position  int
+	top       bool
+	bottom    bool
+	toList    string
+	toGroup   string
+	toProject string
+	yes       bool
 }
 
 func newMoveCmd(f *factory.Factory) *cobra.Command {
@@ -21,14 +31,20 @@ func newMoveCmd(f *factory.Factory) *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:   "move <todo-id|url>",
-		Short: "Move a todo to a different position within its list",
-		Long: `Move a todo to a different position within its todo list.
+		Short: "Move a todo within its list, to another list or group, or to another project",
+		Long: `Move a todo to a different position, todo list, group, or project.
 
 You can specify the todo using either:
 - A numeric ID (e.g., "12345")
 - A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
 
-Position is 1-based (1 = first item in the list).`,
+Position is 1-based (1 = first item in the list).
+
+Within a project, --to-list and --to-group move the todo in place, keeping its
+ID, comments and history. Moving to another project (--to-project, or a
+--to-list URL from another project) isn't supported by Basecamp directly, so
+the todo is recreated there with its content, assignees, dates, attachments
+and comments (as quoted history), and the original is moved to the trash.`,
 		Example: `  # Move todo to specific position
   bc4 todo move 12345 --position 1      # Move to top (first position)
   bc4 todo move 12345 --position 3      # Move to 3rd position
@@ -38,7 +54,14 @@ Position is 1-based (1 = first item in the list).`,
   bc4 todo move 12345 --bottom          # Move to bottom of list
 
   # Move using a URL
-  bc4 todo move https://3.basecamp.com/.../todos/12345 --position 1`,
+  bc4 todo move https://3.basecamp.com/.../todos/12345 --position 1
+
+  # Move to another list or group in the same project
+  bc4 todo move 12345 --to-list "Sprint 2"
+  bc4 todo move 12345 --to-list "Sprint 2" --to-group "In Progress" --bottom
+
+  # Move to a list in another project
+  bc4 todo move 12345 --to-project "Website Redesign" --to-list "Backlog"`,
 		Args: cmdutil.ExactArgs(1, "todo-id"),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runMove(f, opts, args)
@@ -48,6 +71,10 @@ Position is 1-based (1 = first item in the list).`,
 	cmd.Flags().IntVar(&opts.position, "position", 0, "Move to specific position (1-based)")
 	cmd.Flags().BoolVar(&opts.top, "top", false, "Move to top of list (position 1)")
 	cmd.Flags().BoolVar(&opts.bottom, "bottom", false, "Move to bottom of list")
+	cmd.Flags().StringVar(&opts.toList, "to-list", "", "Destination todo list ID, name, or URL")
+	cmd.Flags().StringVar(&opts.toGroup, "to-group", "", "Destination group ID, name, or URL within the list")
+	cmd.Flags().StringVar(&opts.toProject, "to-project", "", "Destination project ID, name, or URL (recreates the todo there)")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip confirmation when moving to another project")
 
 	return cmd
 }
@@ -84,8 +111,9 @@ func runMove(f *factory.Factory, opts *moveOptions, args []string) error {
 		optionCount++
 	}
 
-	if optionCount == 0 {
-		return fmt.Errorf("specify a position using --position, --top, or --bottom")
+	relocating := opts.toList != "" || opts.toGroup != "" || opts.toProject != ""
+	if optionCount == 0 && !relocating {
+		return fmt.Errorf("specify a destination using --to-list, --to-group or --to-project, or a position using --position, --top, or --bottom")
 	}
 	if optionCount > 1 {
 		return fmt.Errorf("only one of --position, --top, or --bottom can be specified")
@@ -104,6 +132,10 @@ func runMove(f *factory.Factory, opts *moveOptions, args []string) error {
 		return err
 	}
 
+	if relocating {
+		return runRelocate(f, client, opts, projectID, todoID)
+	}
+
 	// Determine position
 	var position int
 	var positionLabel string
@@ -145,3 +177,262 @@ func runMove(f *factory.Factory, opts *moveOptions, args []string) error {
 
 	return nil
 }
+
+// moveDestination is where a todo is being moved to
+type moveDestination struct {
+	projectID string
+	listID    int64
+	listTitle string
+	parentID  int64 // group ID, or the list ID when no group was given
+	label     string
+}
+
+// runRelocate moves a todo to another list or group, recreating it when the
+// destination is in a different project
+func runRelocate(f *factory.Factory, client *api.ModularClient, opts *moveOptions, projectID string, todoID int64) error {
+	ctx := f.Context()
+	todoOps := client.Todos()
+
+	todo, err := todoOps.GetTodo(ctx, projectID, todoID)
+	if err != nil {
+		return fmt.Errorf("failed to get todo: %w", err)
+	}
+
+	dest, err := resolveMoveDestination(f, client, opts, projectID, todo)
+	if err != nil {
+		return err
+	}
+
+	position := 1
+	switch {
+	case opts.position > 0:
+		position = opts.position
+	case opts.bottom:
+		todos, err := todoOps.GetTodos(ctx, dest.projectID, dest.parentID)
+		if err != nil {
+			return fmt.Errorf("failed to get todos in destination: %w", err)
+		}
+		position = len(todos) + 1
+	}
+
+	if dest.projectID == projectID {
+		if err := todoOps.MoveTodo(ctx, projectID, todoID, dest.parentID, position); err != nil {
+			return err
+		}
+		if ui.IsTerminal(os.Stdout) {
+			fmt.Printf("✓ Moved #%d to %s\n", todoID, dest.label)
+		} else {
+			fmt.Println(todoID)
+		}
+		return nil
+	}
+
+	if !opts.yes {
+		if !ui.IsTerminal(os.Stdout) {
+			return fmt.Errorf("moving to another project trashes the original todo; use --yes to confirm")
+		}
+		var confirm bool
+		if err := huh.NewConfirm().
+			Title(fmt.Sprintf("Move \"%s\" to %s?", todo.Title, dest.label)).
+			Description("The todo will be recreated in the destination project and the original moved to the trash.").
+			Affirmative("Move").
+			Negative("Cancel").
+			Value(&confirm).
+			Run(); err != nil {
+			return err
+		}
+		if !confirm {
+			fmt.Println("Canceled")
+			return nil
+		}
+	}
+
+	newTodo, err := moveTodoToProject(f, client, projectID, todo, dest, opts.position > 0 || opts.bottom, position)
+	if err != nil {
+		return err
+	}
+
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Printf("✓ Moved #%d to %s as #%d\n", todoID, dest.label, newTodo.ID)
+	} else {
+		fmt.Println(newTodo.ID)
+	}
+	return nil
+}
+
+// resolveMoveDestination works out the destination project, list and group
+func resolveMoveDestination(f *factory.Factory, client *api.ModularClient, opts *moveOptions, projectID string, todo *api.Todo) (*moveDestination, error) {
+	ctx := f.Context()
+	todoOps := client.Todos()
+	dest := &moveDestination{projectID: projectID}
+	projectName := ""
+
+	if opts.toProject != "" {
+		project, err := utils.ResolveProject(f.Context(), client.Projects(), opts.toProject)
+		if err != nil {
+			return nil, err
+		}
+		dest.projectID = strconv.FormatInt(project.ID, 10)
+		projectName = project.Name
+	}
+
+	// A list URL carries its project, which takes effect unless --to-project was given
+	if parser.IsBasecampURL(opts.toList) {
+		parsed, err := parser.ParseBasecampURL(opts.toList)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ResourceType != parser.ResourceTypeTodoList {
+			return nil, fmt.Errorf("URL is not a todo list URL: %s", opts.toList)
+		}
+		if opts.toProject == "" && parsed.ProjectID > 0 {
+			dest.projectID = strconv.FormatInt(parsed.ProjectID, 10)
+		}
+		dest.listID = parsed.ResourceID
+	}
+
+	if dest.projectID != projectID && opts.toList == "" {
+		return nil, fmt.Errorf("--to-list is required when moving to another project")
+	}
+
+	switch {
+	case dest.listID != 0:
+		list, err := todoOps.GetTodoList(ctx, dest.projectID, dest.listID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get todo list: %w", err)
+		}
+		dest.listTitle = list.Title
+	case opts.toList != "":
+		list, err := resolveTodoList(f, client, dest.projectID, opts.toList)
+		if err != nil {
+			return nil, err
+		}
+		dest.listID = list.ID
+		dest.listTitle = list.Title
+	default:
+		// Only --to-group was given: stay in the todo's current list
+		list, err := todoOps.GetTodoList(ctx, projectID, todo.TodolistID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current todo list: %w", err)
+		}
+		dest.listID = list.ID
+		dest.listTitle = list.Title
+	}
+
+	dest.parentID = dest.listID
+	dest.label = dest.listTitle
+
+	if opts.toGroup != "" {
+		group, err := resolveTodoGroup(f, client, dest.projectID, dest.listID, opts.toGroup)
+		if err != nil {
+			return nil, err
+		}
+		dest.parentID = group.ID
+		dest.label += " › " + group.Title
+	}
+
+	if dest.projectID != projectID {
+		if projectName == "" {
+			project, err := client.Projects().GetProject(ctx, dest.projectID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch project: %w", err)
+			}
+			projectName = project.Name
+		}
+		dest.label = projectName + " › " + dest.label
+	}
+
+	return dest, nil
+}
+
+// moveTodoToProject recreates a todo in another project, carrying over its
+// content, dates, assignees, attachments and comments, then trashes the
+// original. The original is kept if anything but attachments fails to copy.
+func moveTodoToProject(f *factory.Factory, client *api.ModularClient, projectID string, todo *api.Todo, dest *moveDestination, reposition bool, position int) (*api.Todo, error) {
+	ctx := f.Context()
+	warn := func(format string, args ...interface{}) {
+		fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", args...)
+	}
+
+	description, errs := utils.RehostAttachments(ctx, client.Uploads(), client.Attachments(), projectID, todo.Description)
+	for _, err := range errs {
+		warn("attachment not copied: %v", err)
+	}
+
+	title := todo.Content
+	if title == "" {
+		title = todo.Title
+	}
+	req := api.TodoCreateRequest{
+		Content:     title,
+		Description: description,
+		DueOn:       todo.DueOn,
+		StartsOn:    todo.StartsOn,
+	}
+
+	// Only people with access to the destination project can be assigned
+	if len(todo.Assignees) > 0 {
+		people, err := client.People().GetProjectPeople(ctx, dest.projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch destination project people: %w", err)
+		}
+		members := make(map[int64]bool, len(people))
+		for _, person := range people {
+			members[person.ID] = true
+		}
+		for _, assignee := range todo.Assignees {
+			if members[assignee.ID] {
+				req.AssigneeIDs = append(req.AssigneeIDs, assignee.ID)
+			} else {
+				warn("%s is not on the destination project and was unassigned", assignee.Name)
+			}
+		}
+	}
+
+	newTodo, err := client.Todos().CreateTodo(ctx, dest.projectID, dest.parentID, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create todo in destination: %w", err)
+	}
+
+	keepOriginal := false
+
+	comments, err := client.Comments().ListComments(ctx, projectID, todo.ID)
+	if err != nil {
+		warn("failed to fetch comments: %v", err)
+		keepOriginal = true
+	}
+	for _, comment := range comments {
+		content, errs := utils.RehostAttachments(ctx, client.Uploads(), client.Attachments(), projectID, comment.Content)
+		for _, err := range errs {
+			warn("attachment in comment #%d not copied: %v", comment.ID, err)
+		}
+		commentReq := api.CommentCreateRequest{Content: utils.QuoteComment(comment, content)}
+		if _, err := client.Comments().CreateComment(ctx, dest.projectID, newTodo.ID, commentReq); err != nil {
+			warn("failed to copy comment #%d: %v", comment.ID, err)
+			keepOriginal = true
+		}
+	}
+
+	if todo.Completed {
+		if err := client.Todos().CompleteTodo(ctx, dest.projectID, newTodo.ID); err != nil {
+			warn("failed to mark the new todo complete: %v", err)
+		}
+	}
+
+	if reposition {
+		if err := client.Todos().RepositionTodo(ctx, dest.projectID, newTodo.ID, position); err != nil {
+			warn("failed to reposition the new todo: %v", err)
+		}
+	}
+
+	if keepOriginal {
+		warn("original todo #%d was kept because some comments could not be copied", todo.ID)
+		return newTodo, nil
+	}
+
+	if err := client.Activity().TrashRecording(ctx, projectID, todo.ID); err != nil {
+		return nil, fmt.Errorf("created #%d but failed to trash the original: %w", newTodo.ID, err)
+	}
+
+	return newTodo, nil
+}
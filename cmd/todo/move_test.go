@@ -0,0 +1,43 @@
+package todo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/needmore/bc4/internal/factory"
+)
+
+func TestMoveCommandFlags(t *testing.T) {
+	cmd := newMoveCmd(&factory.Factory{})
+
+	for _, name := range []string{"position", "top", "bottom", "to-list", "to-group", "to-project", "yes"} {
+		assert.NotNil(t, cmd.Flag(name), "flag %s should exist", name)
+	}
+}
+
+func TestMoveRequiresDestination(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    moveOptions
+		wantErr string
+	}{
+		{
+			name:    "no destination or position",
+			opts:    moveOptions{},
+			wantErr: "specify a destination",
+		},
+		{
+			name:    "conflicting positions",
+			opts:    moveOptions{top: true, bottom: true, toList: "Backlog"},
+			wantErr: "only one of",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runMove(&factory.Factory{}, &tt.opts, []string{"12345"})
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
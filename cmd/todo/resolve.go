@@ -0,0 +1,121 @@
+package todo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// resolveTodoList finds a todo list in a project by ID, URL, or name
+func resolveTodoList(f *factory.Factory, client *api.ModularClient, projectID string, identifier string) (*api.TodoList, error) {
+	todoOps := client.Todos()
+
+	if parser.IsBasecampURL(identifier) {
+		parsed, err := parser.ParseBasecampURL(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ResourceType != parser.ResourceTypeTodoList {
+			return nil, fmt.Errorf("URL is not a todo list URL: %s", identifier)
+		}
+		if parsed.ProjectID > 0 {
+			projectID = strconv.FormatInt(parsed.ProjectID, 10)
+		}
+		list, err := todoOps.GetTodoList(f.Context(), projectID, parsed.ResourceID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get todo list: %w", err)
+		}
+		return list, nil
+	}
+
+	todoSet, err := todoOps.GetProjectTodoSet(f.Context(), projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project todo set: %w", err)
+	}
+	lists, err := todoOps.GetTodoLists(f.Context(), projectID, todoSet.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch todo lists: %w", err)
+	}
+	for _, list := range lists {
+		if strconv.FormatInt(list.ID, 10) == identifier ||
+			strings.EqualFold(list.Title, identifier) ||
+			strings.EqualFold(list.Name, identifier) {
+			l := list
+			return &l, nil
+		}
+	}
+
+	return nil, fmt.Errorf("todo list not found: %s", identifier)
+}
+
+// resolveTodoGroup finds a group within a todo list by ID, URL, or name
+func resolveTodoGroup(f *factory.Factory, client *api.ModularClient, projectID string, listID int64, identifier string) (*api.TodoGroup, error) {
+	var groupID int64
+	if parser.IsBasecampURL(identifier) {
+		parsed, err := parser.ParseBasecampURL(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ResourceType != parser.ResourceTypeTodoGroup {
+			return nil, fmt.Errorf("URL is not a todo group URL: %s", identifier)
+		}
+		groupID = parsed.ResourceID
+	}
+
+	groups, err := client.Todos().GetTodoGroups(f.Context(), projectID, listID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch todo groups: %w", err)
+	}
+	for _, group := range groups {
+		if group.ID == groupID ||
+			strconv.FormatInt(group.ID, 10) == identifier ||
+			strings.EqualFold(group.Title, identifier) ||
+			strings.EqualFold(group.Name, identifier) {
+			g := group
+			return &g, nil
+		}
+	}
+
+	return nil, fmt.Errorf("todo group not found: %s", identifier)
+}
+
+// resolveTodoListOrDefault finds a todo list by ID, URL, or name, falling back
+// to the project's default list set with 'bc4 todo set'
+func resolveTodoListOrDefault(f *factory.Factory, client *api.ModularClient, projectID string, identifier string) (*api.TodoList, error) {
+	if identifier != "" {
+		return resolveTodoList(f, client, projectID, identifier)
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := f.AccountID()
+	if err != nil {
+		return nil, err
+	}
+
+	defaultTodoListID := ""
+	if acc, ok := cfg.Accounts[accountID]; ok {
+		if projDefaults, ok := acc.ProjectDefaults[projectID]; ok {
+			defaultTodoListID = projDefaults.DefaultTodoList
+		}
+	}
+	if defaultTodoListID == "" {
+		return nil, fmt.Errorf("no todo list specified. Use --list flag or run 'bc4 todo set' to set a default")
+	}
+
+	listID, err := strconv.ParseInt(defaultTodoListID, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default todo list ID in config")
+	}
+	list, err := client.Todos().GetTodoList(f.Context(), projectID, listID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get todo list: %w", err)
+	}
+	return list, nil
+}
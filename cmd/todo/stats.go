@@ -0,0 +1,522 @@
+package todo
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+type statsOptions struct {
+	days      int
+	formatStr string
+	by        string
+}
+
+// completionEvent is a todo being checked or unchecked
+type completionEvent struct {
+	at        time.Time
+	completed bool
+}
+
+// todoHistory is a todo with its completion events in time order
+type todoHistory struct {
+	todo    api.Todo
+	created time.Time
+	events  []completionEvent
+}
+
+type dayStats struct {
+	Date      string `json:"date"`
+	Completed int    `json:"completed"`
+	Reopened  int    `json:"reopened"`
+	Open      int    `json:"open"`
+}
+
+type assigneeStats struct {
+	Name              string   `json:"name"`
+	Open              int      `json:"open"`
+	Completed         int      `json:"completed"`
+	CompletedInPeriod int      `json:"completed_in_period"`
+	AvgDaysToComplete *float64 `json:"avg_days_to_complete"`
+}
+
+type todoStats struct {
+	Scope             string          `json:"scope"`
+	From              string          `json:"from"`
+	To                string          `json:"to"`
+	Total             int             `json:"total"`
+	Open              int             `json:"open"`
+	Completed         int             `json:"completed"`
+	CompletedInPeriod int             `json:"completed_in_period"`
+	AvgDaysToComplete *float64        `json:"avg_days_to_complete"`
+	Daily             []dayStats      `json:"daily"`
+	Assignees         []assigneeStats `json:"assignees"`
+}
+
+func newStatsCmd(f *factory.Factory) *cobra.Command {
+	opts := &statsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "stats [list|project]",
+		Short: "Show completion trends and a burndown for a list or project",
+		Long: `Show progress analytics for a todo list, or for every list in a project.
+
+Completion history is rebuilt from each todo's events, so the burndown
+reflects todos being checked and unchecked over time. The report covers:
+
+  - Todos completed (and reopened) per day
+  - A burndown of open todos
+  - A per-assignee breakdown
+  - Average time from creation to completion
+
+The argument may be a list ID, name or URL, or a project name, ID or URL.
+Without one, the whole current project is reported.`,
+		Example: `  # Burndown for a list over the last 30 days
+  bc4 todo stats "Sprint 12"
+
+  # Two weeks of the whole project
+  bc4 todo stats --days 14
+
+  # Weekly report exports
+  bc4 todo stats "Sprint 12" --days 7 --format json > sprint.json
+  bc4 todo stats "Sprint 12" --format csv --by assignee > people.csv`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg := ""
+			if len(args) > 0 {
+				arg = args[0]
+			}
+			return runStats(f, opts, arg)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.days, "days", 30, "Number of days to report on")
+	cmd.Flags().StringVarP(&opts.formatStr, "format", "f", "table", "Output format: table, json, or csv")
+	cmd.Flags().StringVar(&opts.by, "by", "day", "Rows for CSV output: day or assignee")
+
+	return cmd
+}
+
+func runStats(f *factory.Factory, opts *statsOptions, arg string) error {
+	format, err := ui.ParseOutputFormat(opts.formatStr)
+	if err != nil {
+		return err
+	}
+	if opts.days < 1 {
+		return fmt.Errorf("--days must be at least 1")
+	}
+	if opts.by != "day" && opts.by != "assignee" {
+		return fmt.Errorf("invalid --by value %q: must be day or assignee", opts.by)
+	}
+
+	if parser.IsBasecampURL(arg) {
+		parsed, err := parser.ParseBasecampURL(arg)
+		if err != nil {
+			return fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
+		}
+		if parsed.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsed.ProjectID, 10))
+		}
+		if parsed.ResourceType == parser.ResourceTypeProject {
+			arg = ""
+		}
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	loc, err := f.Location()
+	if err != nil {
+		return err
+	}
+
+	projectID, scope, lists, err := resolveStatsScope(f, client, arg)
+	if err != nil {
+		return err
+	}
+
+	histories, err := fetchTodoHistories(f, client, projectID, lists)
+	if err != nil {
+		return err
+	}
+
+	stats := computeTodoStats(histories, time.Now().In(loc), opts.days)
+	stats.Scope = scope
+
+	switch format {
+	case ui.OutputFormatJSON:
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(stats)
+	case ui.OutputFormatCSV:
+		return writeStatsCSV(stats, opts.by)
+	}
+
+	return renderStats(stats, opts.days)
+}
+
+// resolveStatsScope works out the project and lists to report on
+func resolveStatsScope(f *factory.Factory, client *api.ModularClient, arg string) (string, string, []api.TodoList, error) {
+	ctx := f.Context()
+	todoOps := client.Todos()
+
+	if arg != "" {
+		if projectID, err := f.ProjectID(); err == nil {
+			if list, err := resolveTodoList(f, client, projectID, arg); err == nil {
+				return projectID, list.Title, []api.TodoList{*list}, nil
+			}
+		}
+
+		project, err := utils.ResolveProject(f.Context(), client.Projects(), arg)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("no todo list or project found matching '%s'", arg)
+		}
+		f = f.WithProject(strconv.FormatInt(project.ID, 10))
+	}
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return "", "", nil, err
+	}
+	project, err := client.Projects().GetProject(ctx, projectID)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to fetch project: %w", err)
+	}
+	todoSet, err := todoOps.GetProjectTodoSet(ctx, projectID)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to get project todo set: %w", err)
+	}
+	lists, err := todoOps.GetTodoLists(ctx, projectID, todoSet.ID)
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to fetch todo lists: %w", err)
+	}
+	return projectID, project.Name, lists, nil
+}
+
+// fetchTodoHistories loads every todo in the lists along with its
+// completion events
+func fetchTodoHistories(f *factory.Factory, client *api.ModularClient, projectID string, lists []api.TodoList) ([]todoHistory, error) {
+	ctx := f.Context()
+
+	var todos []api.Todo
+	for _, list := range lists {
+		listTodos, err := fetchListTodos(ctx, client.Todos(), projectID, list, true)
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, listTodos...)
+	}
+
+	histories := make([]todoHistory, len(todos))
+	limiter := api.GetRateLimiter()
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(bulkConcurrency)
+	for i, todo := range todos {
+		g.Go(func() error {
+			limiter.Wait()
+			events, err := client.Activity().ListEvents(gctx, projectID, todo.ID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch history of todo #%d: %w", todo.ID, err)
+			}
+			histories[i] = newTodoHistory(todo, events)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+// newTodoHistory extracts completion events. A completed todo without a
+// completion event is treated as completed when it was last updated.
+func newTodoHistory(todo api.Todo, events []api.Event) todoHistory {
+	h := todoHistory{todo: todo}
+	h.created, _ = time.Parse(time.RFC3339, todo.CreatedAt)
+
+	for _, event := range events {
+		switch event.Action {
+		case "completed":
+			h.events = append(h.events, completionEvent{at: event.CreatedAt, completed: true})
+		case "uncompleted":
+			h.events = append(h.events, completionEvent{at: event.CreatedAt, completed: false})
+		}
+	}
+	sort.SliceStable(h.events, func(i, j int) bool { return h.events[i].at.Before(h.events[j].at) })
+
+	if todo.Completed && (len(h.events) == 0 || !h.events[len(h.events)-1].completed) {
+		updated, err := time.Parse(time.RFC3339, todo.UpdatedAt)
+		if err != nil {
+			updated = time.Now()
+		}
+		h.events = append(h.events, completionEvent{at: updated, completed: true})
+	}
+
+	return h
+}
+
+// openAt reports whether the todo existed and was open at t
+func (h todoHistory) openAt(t time.Time) bool {
+	if !h.created.IsZero() && h.created.After(t) {
+		return false
+	}
+	open := true
+	for _, e := range h.events {
+		if e.at.After(t) {
+			break
+		}
+		open = !e.completed
+	}
+	return open
+}
+
+// daysToComplete returns how long a completed todo took from creation to
+// its final completion
+func (h todoHistory) daysToComplete() (float64, bool) {
+	if !h.todo.Completed || len(h.events) == 0 || h.created.IsZero() {
+		return 0, false
+	}
+	last := h.events[len(h.events)-1]
+	if !last.completed {
+		return 0, false
+	}
+	return last.at.Sub(h.created).Hours() / 24, true
+}
+
+// computeTodoStats builds the report for the days up to and including today
+func computeTodoStats(histories []todoHistory, today time.Time, days int) *todoStats {
+	loc := today.Location()
+	start := time.Date(today.Year(), today.Month(), today.Day()-(days-1), 0, 0, 0, 0, loc)
+	end := start.AddDate(0, 0, days)
+
+	stats := &todoStats{
+		From:  start.Format("2006-01-02"),
+		To:    today.Format("2006-01-02"),
+		Total: len(histories),
+	}
+
+	for d := 0; d < days; d++ {
+		dayStart := start.AddDate(0, 0, d)
+		dayEnd := dayStart.AddDate(0, 0, 1)
+		day := dayStats{Date: dayStart.Format("2006-01-02")}
+		for _, h := range histories {
+			for _, e := range h.events {
+				if e.at.Before(dayStart) || !e.at.Before(dayEnd) {
+					continue
+				}
+				if e.completed {
+					day.Completed++
+				} else {
+					day.Reopened++
+				}
+			}
+			if h.openAt(dayEnd.Add(-time.Nanosecond)) {
+				day.Open++
+			}
+		}
+		stats.CompletedInPeriod += day.Completed
+		stats.Daily = append(stats.Daily, day)
+	}
+
+	byName := make(map[string]*assigneeStats)
+	durations := make(map[string][]float64)
+	var allDurations []float64
+
+	for _, h := range histories {
+		if h.todo.Completed {
+			stats.Completed++
+		} else {
+			stats.Open++
+		}
+
+		completedInPeriod := 0
+		for _, e := range h.events {
+			if e.completed && !e.at.Before(start) && e.at.Before(end) {
+				completedInPeriod++
+			}
+		}
+		took, hasDuration := h.daysToComplete()
+		if hasDuration {
+			allDurations = append(allDurations, took)
+		}
+
+		names := []string{"Unassigned"}
+		if len(h.todo.Assignees) > 0 {
+			names = names[:0]
+			for _, person := range h.todo.Assignees {
+				names = append(names, person.Name)
+			}
+		}
+		for _, name := range names {
+			a, ok := byName[name]
+			if !ok {
+				a = &assigneeStats{Name: name}
+				byName[name] = a
+			}
+			if h.todo.Completed {
+				a.Completed++
+			} else {
+				a.Open++
+			}
+			a.CompletedInPeriod += completedInPeriod
+			if hasDuration {
+				durations[name] = append(durations[name], took)
+			}
+		}
+	}
+
+	stats.AvgDaysToComplete = averageDays(allDurations)
+	for name, a := range byName {
+		a.AvgDaysToComplete = averageDays(durations[name])
+		stats.Assignees = append(stats.Assignees, *a)
+	}
+	sort.Slice(stats.Assignees, func(i, j int) bool {
+		a, b := stats.Assignees[i], stats.Assignees[j]
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return a.Name < b.Name
+	})
+
+	return stats
+}
+
+// averageDays returns the mean rounded to one decimal, or nil without data
+func averageDays(values []float64) *float64 {
+	if len(values) == 0 {
+		return nil
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	avg := math.Round(sum/float64(len(values))*10) / 10
+	return &avg
+}
+
+func formatAvgDays(avg *float64) string {
+	if avg == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(*avg, 'f', 1, 64)
+}
+
+func renderStats(stats *todoStats, days int) error {
+	percent := 0
+	if stats.Total > 0 {
+		percent = stats.Completed * 100 / stats.Total
+	}
+
+	openSeries := make([]int, len(stats.Daily))
+	doneSeries := make([]int, len(stats.Daily))
+	for i, day := range stats.Daily {
+		openSeries[i] = day.Open
+		doneSeries[i] = day.Completed
+	}
+
+	fmt.Printf("%s · last %d days (%s – %s)\n\n", lipgloss.NewStyle().Bold(true).Render(stats.Scope), days, stats.From, stats.To)
+	fmt.Printf("  Open         %d of %d (%d%% complete)\n", stats.Open, stats.Total, percent)
+	fmt.Printf("  Completed    %d in period · avg %s days to complete\n\n", stats.CompletedInPeriod, formatAvgDays(stats.AvgDaysToComplete))
+	if len(stats.Daily) > 0 {
+		first, last := stats.Daily[0].Open, stats.Daily[len(stats.Daily)-1].Open
+		fmt.Printf("  Burndown     %s  %d → %d open\n", ui.Sparkline(openSeries), first, last)
+		fmt.Printf("  Done/day     %s  max %d\n\n", ui.Sparkline(doneSeries), maxInt(doneSeries))
+	}
+
+	daily := tableprinter.New(os.Stdout)
+	daily.AddHeader("DATE", "COMPLETED", "REOPENED", "OPEN")
+	active := 0
+	for _, day := range stats.Daily {
+		if day.Completed == 0 && day.Reopened == 0 {
+			continue
+		}
+		active++
+		daily.AddField(day.Date)
+		daily.AddField(strconv.Itoa(day.Completed))
+		daily.AddField(strconv.Itoa(day.Reopened))
+		daily.AddField(strconv.Itoa(day.Open))
+		daily.EndRow()
+	}
+	if active > 0 {
+		if err := daily.Render(); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+
+	people := tableprinter.New(os.Stdout)
+	people.AddHeader("ASSIGNEE", "OPEN", "COMPLETED", "IN PERIOD", "AVG DAYS")
+	for _, a := range stats.Assignees {
+		people.AddField(a.Name)
+		people.AddField(strconv.Itoa(a.Open))
+		people.AddField(strconv.Itoa(a.Completed))
+		people.AddField(strconv.Itoa(a.CompletedInPeriod))
+		people.AddField(formatAvgDays(a.AvgDaysToComplete))
+		people.EndRow()
+	}
+	return people.Render()
+}
+
+func maxInt(values []int) int {
+	m := 0
+	for _, v := range values {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
+
+func writeStatsCSV(stats *todoStats, by string) error {
+	writer := csv.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	if by == "assignee" {
+		if err := writer.Write([]string{"assignee", "open", "completed", "completed_in_period", "avg_days_to_complete"}); err != nil {
+			return err
+		}
+		for _, a := range stats.Assignees {
+			avg := ""
+			if a.AvgDaysToComplete != nil {
+				avg = formatAvgDays(a.AvgDaysToComplete)
+			}
+			record := []string{a.Name, strconv.Itoa(a.Open), strconv.Itoa(a.Completed), strconv.Itoa(a.CompletedInPeriod), avg}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		return writer.Error()
+	}
+
+	if err := writer.Write([]string{"date", "completed", "reopened", "open"}); err != nil {
+		return err
+	}
+	for _, day := range stats.Daily {
+		record := []string{day.Date, strconv.Itoa(day.Completed), strconv.Itoa(day.Reopened), strconv.Itoa(day.Open)}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	return writer.Error()
+}
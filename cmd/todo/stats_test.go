@@ -0,0 +1,70 @@
+package todo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestComputeTodoStats(t *testing.T) {
+	today := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	day := func(d, h int) time.Time { return time.Date(2025, 3, d, h, 0, 0, 0, time.UTC) }
+
+	ada := api.Person{ID: 1, Name: "Ada"}
+	histories := []todoHistory{
+		newTodoHistory(api.Todo{ID: 1, Completed: true, CreatedAt: "2025-03-01T09:00:00Z", Assignees: []api.Person{ada}},
+			[]api.Event{{Action: "completed", CreatedAt: day(8, 9)}}),
+		newTodoHistory(api.Todo{ID: 2, CreatedAt: "2025-03-01T09:00:00Z", Assignees: []api.Person{ada}},
+			[]api.Event{
+				{Action: "created", CreatedAt: day(1, 9)},
+				{Action: "completed", CreatedAt: day(8, 10)},
+				{Action: "uncompleted", CreatedAt: day(9, 10)},
+			}),
+		// Completed without a completion event falls back to updated_at
+		newTodoHistory(api.Todo{ID: 3, Completed: true, CreatedAt: "2025-03-05T09:00:00Z", UpdatedAt: "2025-03-10T09:00:00Z"}, nil),
+		// Created after the first reported day
+		newTodoHistory(api.Todo{ID: 4, CreatedAt: "2025-03-09T12:00:00Z"}, nil),
+	}
+
+	stats := computeTodoStats(histories, today, 3)
+
+	assert.Equal(t, "2025-03-08", stats.From)
+	assert.Equal(t, "2025-03-10", stats.To)
+	assert.Equal(t, 4, stats.Total)
+	assert.Equal(t, 2, stats.Open)
+	assert.Equal(t, 2, stats.Completed)
+	assert.Equal(t, 3, stats.CompletedInPeriod)
+
+	assert.Equal(t, []dayStats{
+		{Date: "2025-03-08", Completed: 2, Open: 1},
+		{Date: "2025-03-09", Reopened: 1, Open: 3},
+		{Date: "2025-03-10", Completed: 1, Open: 2},
+	}, stats.Daily)
+
+	require.NotNil(t, stats.AvgDaysToComplete)
+	assert.Equal(t, 6.0, *stats.AvgDaysToComplete)
+
+	require.Len(t, stats.Assignees, 2)
+	assert.Equal(t, "Ada", stats.Assignees[0].Name)
+	assert.Equal(t, 1, stats.Assignees[0].Open)
+	assert.Equal(t, 1, stats.Assignees[0].Completed)
+	assert.Equal(t, 2, stats.Assignees[0].CompletedInPeriod)
+	require.NotNil(t, stats.Assignees[0].AvgDaysToComplete)
+	assert.Equal(t, 7.0, *stats.Assignees[0].AvgDaysToComplete)
+
+	assert.Equal(t, "Unassigned", stats.Assignees[1].Name)
+	assert.Equal(t, 1, stats.Assignees[1].Open)
+	assert.Equal(t, 1, stats.Assignees[1].Completed)
+}
+
+func TestComputeTodoStatsEmpty(t *testing.T) {
+	stats := computeTodoStats(nil, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), 7)
+
+	assert.Len(t, stats.Daily, 7)
+	assert.Nil(t, stats.AvgDaysToComplete)
+	assert.Empty(t, stats.Assignees)
+}
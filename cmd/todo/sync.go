@@ -0,0 +1,812 @@
+package todo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/parser"
+	"github.com/needmore/bc4/internal/ui"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+type syncOptions struct {
+	prefer        string
+	dryRun        bool
+	deleteRemoved bool
+}
+
+// syncState records what the file and Basecamp looked like after the last
+// sync, so later runs can tell which side changed
+type syncState struct {
+	ProjectID string       `json:"project_id"`
+	ListID    int64        `json:"list_id"`
+	SyncedAt  string       `json:"synced_at"`
+	Todos     []syncedTodo `json:"todos"`
+}
+
+type syncedTodo struct {
+	checklist.Item
+	UpdatedAt string `json:"updated_at"`
+}
+
+// remoteTodo is a todo in Basecamp along with its checklist form
+type remoteTodo struct {
+	item checklist.Item
+	todo api.Todo
+}
+
+// remoteList is the current state of a todo list in Basecamp
+type remoteList struct {
+	list   *api.TodoList
+	groups []api.TodoGroup
+	todos  []remoteTodo
+}
+
+// syncUpdate is a local change to push to an existing todo
+type syncUpdate struct {
+	remote remoteTodo
+	local  checklist.Item
+}
+
+// syncPlan is the merged result of the file, Basecamp and the last sync
+type syncPlan struct {
+	final     []checklist.Item
+	updates   []syncUpdate
+	trash     []remoteTodo
+	conflicts []string
+	notes     []string
+	pulled    int
+}
+
+func newSyncCmd(f *factory.Factory) *cobra.Command {
+	opts := &syncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync <list-id|name|url> <file.md>",
+		Short: "Sync a todo list with a local Markdown checklist",
+		Long: `Keep a todo list and a Markdown file in step.
+
+The first run writes the list to the file: groups become headings and todos
+become "- [ ]" items tagged with a <!-- bc4:id --> marker. On later runs the
+file is compared with Basecamp and changes flow both ways:
+
+  - New items in the file are created in Basecamp
+  - Checking, unchecking, retitling, re-describing or reassigning (@name)
+    an item is pushed
+  - Moving an item under another heading moves it to that group
+  - Reordering items in the file repositions them in Basecamp
+  - Changes made in Basecamp are pulled into the file
+
+A todo changed on both sides since the last sync (detected by its
+updated_at timestamp) is a conflict. Nothing is changed until conflicts are
+resolved with --prefer local or --prefer remote.
+
+Sync state is kept next to the file in .<file>.bc4sync; commit it alongside
+the file so everyone syncs from the same baseline. The file is rewritten on
+every sync, so keep other notes elsewhere.`,
+		Example: `  # Mirror the release checklist into the repo
+  bc4 todo sync "Release checklist" docs/RELEASE.md
+
+  # Preview what a sync would do
+  bc4 todo sync "Release checklist" docs/RELEASE.md --dry-run
+
+  # Resolve conflicts in favor of the file
+  bc4 todo sync "Release checklist" docs/RELEASE.md --prefer local`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(f, opts, args[0], args[1])
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.prefer, "prefer", "", "Resolve conflicts using the local file or Basecamp (local|remote)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show what would change without changing anything")
+	cmd.Flags().BoolVar(&opts.deleteRemoved, "delete", false, "Trash todos that were removed from the file")
+
+	return cmd
+}
+
+func runSync(f *factory.Factory, opts *syncOptions, listArg, path string) error {
+	if opts.prefer != "" && opts.prefer != "local" && opts.prefer != "remote" {
+		return fmt.Errorf("invalid --prefer value %q: must be local or remote", opts.prefer)
+	}
+
+	// A list URL carries its own account and project
+	if parser.IsBasecampURL(listArg) {
+		parsed, err := parser.ParseBasecampURL(listArg)
+		if err != nil {
+			return fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsed.AccountID, 10))
+		}
+		if parsed.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsed.ProjectID, 10))
+		}
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	todoOps := client.Todos()
+	ctx := f.Context()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	list, err := resolveTodoList(f, client, projectID, listArg)
+	if err != nil {
+		return err
+	}
+
+	var local []checklist.Item
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		local, err = checklist.ParseMarkdown(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	state, err := loadSyncState(syncStatePath(path))
+	if err != nil {
+		return err
+	}
+	if state != nil && (state.ListID != list.ID || state.ProjectID != projectID) {
+		return fmt.Errorf("%s was last synced with a different todo list (#%d)", path, state.ListID)
+	}
+
+	people, err := client.People().GetProjectPeople(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch project people: %w", err)
+	}
+	mentions := utils.NewMentions(people, utils.NewUserResolver(client.Client, projectID))
+
+	converter := markdown.NewConverter()
+	remote, err := fetchRemoteList(ctx, todoOps, converter, mentions, projectID, list)
+	if err != nil {
+		return err
+	}
+
+	plan := planSync(local, state, remote, opts.prefer, opts.deleteRemoved)
+
+	if opts.dryRun {
+		printSyncPlan(plan)
+		return nil
+	}
+
+	for _, note := range plan.notes {
+		fmt.Fprintf(os.Stderr, "Note: %s\n", note)
+	}
+	if len(plan.conflicts) > 0 {
+		for _, conflict := range plan.conflicts {
+			fmt.Fprintf(os.Stderr, "✗ %s\n", conflict)
+		}
+		return fmt.Errorf("%d conflicts; nothing was changed. Rerun with --prefer local or --prefer remote", len(plan.conflicts))
+	}
+
+	s := &syncer{
+		ctx:       ctx,
+		todoOps:   todoOps,
+		client:    client,
+		converter: converter,
+		projectID: projectID,
+		list:      list,
+		mentions:  mentions,
+	}
+	created, err := s.push(plan, remote)
+	if err != nil {
+		return err
+	}
+
+	// Re-read Basecamp so the file and state reflect exactly what's there
+	remote, err = fetchRemoteList(ctx, todoOps, converter, mentions, projectID, list)
+	if err != nil {
+		return err
+	}
+	if err := writeSyncFile(path, list, remote); err != nil {
+		return err
+	}
+	if err := saveSyncState(syncStatePath(path), newSyncState(projectID, list.ID, remote)); err != nil {
+		return err
+	}
+
+	pushed := created + len(plan.updates) + len(plan.trash)
+	summary := fmt.Sprintf("Synced %s with %s: %d pushed, %d pulled", list.Title, path, pushed, plan.pulled)
+	if ui.IsTerminal(os.Stdout) {
+		fmt.Println("✓ " + summary)
+	} else {
+		fmt.Println(summary)
+	}
+	return nil
+}
+
+// fetchRemoteList loads the list's groups and todos (including completed
+// ones) in display order, writing assignees as @handles
+func fetchRemoteList(ctx context.Context, todoOps api.TodoOperations, converter markdown.Converter, mentions *utils.Mentions, projectID string, list *api.TodoList) (*remoteList, error) {
+	groups, err := todoOps.GetTodoGroups(ctx, projectID, list.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch todo groups: %w", err)
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Position < groups[j].Position })
+
+	remote := &remoteList{list: list, groups: groups}
+	add := func(parentID int64, group string) error {
+		todos, err := todoOps.GetAllTodos(ctx, projectID, parentID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch todos: %w", err)
+		}
+		for _, todo := range todos {
+			item := checklist.Item{
+				ID:        todo.ID,
+				Title:     todo.Title,
+				Group:     group,
+				Completed: todo.Completed,
+			}
+			if todo.DueOn != nil {
+				item.DueOn = *todo.DueOn
+			}
+			for _, person := range todo.Assignees {
+				item.Assignees = append(item.Assignees, mentions.Mention(person))
+			}
+			if todo.Description != "" {
+				if md, err := converter.RichTextToMarkdown(todo.Description); err == nil {
+					item.Description = md
+				}
+			}
+			remote.todos = append(remote.todos, remoteTodo{item: item, todo: todo})
+		}
+		return nil
+	}
+
+	if err := add(list.ID, ""); err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if err := add(group.ID, group.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	normalizeRemoteItems(remote.todos)
+	return remote, nil
+}
+
+// normalizeRemoteItems round-trips remote items through the Markdown
+// renderer so they compare equal to an untouched file
+func normalizeRemoteItems(todos []remoteTodo) {
+	items := make([]checklist.Item, len(todos))
+	for i, t := range todos {
+		items[i] = t.item
+	}
+
+	var buf bytes.Buffer
+	if err := checklist.Render(&buf, items); err != nil {
+		return
+	}
+	parsed, err := checklist.ParseMarkdown(&buf)
+	if err != nil {
+		return
+	}
+
+	byID := make(map[int64]checklist.Item, len(parsed))
+	for _, item := range parsed {
+		byID[item.ID] = item
+	}
+	for i := range todos {
+		if item, ok := byID[todos[i].item.ID]; ok {
+			todos[i].item = item
+		}
+	}
+}
+
+// planSync merges the file with Basecamp. state may be nil on the first
+// sync, in which case any difference between the two sides is a conflict.
+func planSync(local []checklist.Item, state *syncState, remote *remoteList, prefer string, deleteRemoved bool) *syncPlan {
+	plan := &syncPlan{}
+
+	base := make(map[int64]syncedTodo)
+	if state != nil {
+		for _, t := range state.Todos {
+			base[t.ID] = t
+		}
+	}
+	remoteByID := make(map[int64]int, len(remote.todos))
+	for i, t := range remote.todos {
+		remoteByID[t.item.ID] = i
+	}
+
+	// Match local headings to existing groups regardless of case
+	groupNames := make(map[string]string)
+	for _, group := range remote.groups {
+		groupNames[strings.ToLower(group.Title)] = group.Title
+	}
+	for i := range local {
+		if name, ok := groupNames[strings.ToLower(local[i].Group)]; ok {
+			local[i].Group = name
+		}
+	}
+
+	type entry struct {
+		item      checklist.Item
+		localPos  int
+		remotePos int
+	}
+	var entries []entry
+	inLocal := make(map[int64]bool)
+
+	for i, l := range local {
+		if l.ID == 0 {
+			entries = append(entries, entry{item: l, localPos: i, remotePos: -1})
+			continue
+		}
+		if inLocal[l.ID] {
+			plan.notes = append(plan.notes, fmt.Sprintf("#%d appears more than once in the file; using the first", l.ID))
+			continue
+		}
+		inLocal[l.ID] = true
+
+		b, inBase := base[l.ID]
+		ri, inRemote := remoteByID[l.ID]
+		if !inRemote {
+			plan.notes = append(plan.notes, fmt.Sprintf("#%d %q no longer exists in the list and was removed from the file", l.ID, l.Title))
+			continue
+		}
+		r := remote.todos[ri]
+
+		chosen := r.item
+		if !checklistItemsEqual(l, r.item) {
+			localChanged := !inBase || !checklistItemsEqual(l, b.Item)
+			remoteChanged := !inBase || r.todo.UpdatedAt != b.UpdatedAt
+
+			switch {
+			case localChanged && remoteChanged && prefer == "":
+				plan.conflicts = append(plan.conflicts, fmt.Sprintf("#%d %q changed both locally and in Basecamp", l.ID, l.Title))
+			case localChanged && (!remoteChanged || prefer == "local"):
+				chosen = l
+				plan.updates = append(plan.updates, syncUpdate{remote: r, local: l})
+			default:
+				plan.pulled++
+			}
+		}
+		entries = append(entries, entry{item: chosen, localPos: i, remotePos: ri})
+	}
+
+	for i, r := range remote.todos {
+		if inLocal[r.item.ID] {
+			continue
+		}
+		b, inBase := base[r.item.ID]
+		if inBase {
+			remoteChanged := r.todo.UpdatedAt != b.UpdatedAt
+			switch {
+			case deleteRemoved && !remoteChanged:
+				plan.trash = append(plan.trash, r)
+				continue
+			case deleteRemoved:
+				plan.notes = append(plan.notes, fmt.Sprintf("#%d %q was removed from the file but changed in Basecamp, so it was kept", r.item.ID, r.item.Title))
+			default:
+				plan.notes = append(plan.notes, fmt.Sprintf("#%d %q was removed from the file and restored; use --delete to trash it", r.item.ID, r.item.Title))
+			}
+		} else {
+			plan.pulled++
+		}
+		entries = append(entries, entry{item: r.item, localPos: -1, remotePos: i})
+	}
+
+	// Order groups as in Basecamp, followed by new headings from the file
+	var groupOrder []string
+	seenGroup := map[string]bool{"": true}
+	groupOrder = append(groupOrder, "")
+	for _, group := range remote.groups {
+		if !seenGroup[group.Title] {
+			seenGroup[group.Title] = true
+			groupOrder = append(groupOrder, group.Title)
+		}
+	}
+	for _, e := range entries {
+		if !seenGroup[e.item.Group] {
+			seenGroup[e.item.Group] = true
+			groupOrder = append(groupOrder, e.item.Group)
+		}
+	}
+
+	// Within a group, the file's order wins if it changed since the last
+	// sync; otherwise Basecamp's order is kept
+	var baseOrder []checklist.Item
+	if state != nil {
+		for _, t := range state.Todos {
+			baseOrder = append(baseOrder, t.Item)
+		}
+	}
+	for _, group := range groupOrder {
+		var members []entry
+		for _, e := range entries {
+			if e.item.Group == group {
+				members = append(members, e)
+			}
+		}
+
+		localFirst := !equalIDSequence(groupIDs(local, group), groupIDs(baseOrder, group))
+		sort.SliceStable(members, func(i, j int) bool {
+			a, b := members[i], members[j]
+			if localFirst {
+				return orderBefore(a.localPos, b.localPos, a.remotePos, b.remotePos)
+			}
+			return orderBefore(a.remotePos, b.remotePos, a.localPos, b.localPos)
+		})
+		for _, e := range members {
+			plan.final = append(plan.final, e.item)
+		}
+	}
+
+	return plan
+}
+
+// orderBefore orders by primary position, putting missing (-1) positions
+// last and breaking ties with the secondary position
+func orderBefore(pa, pb, sa, sb int) bool {
+	if (pa == -1) != (pb == -1) {
+		return pb == -1
+	}
+	if pa != pb {
+		return pa < pb
+	}
+	return sa < sb
+}
+
+// groupIDs lists the IDs of items in a group, with 0 for new items
+func groupIDs(items []checklist.Item, group string) []int64 {
+	var ids []int64
+	for _, item := range items {
+		if item.Group == group {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids
+}
+
+func equalIDSequence(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func checklistItemsEqual(a, b checklist.Item) bool {
+	return a.Title == b.Title && a.Description == b.Description && a.Group == b.Group &&
+		a.DueOn == b.DueOn && a.Completed == b.Completed && equalStrings(a.Assignees, b.Assignees)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// syncer pushes a sync plan to Basecamp
+type syncer struct {
+	ctx       context.Context
+	todoOps   api.TodoOperations
+	client    *api.ModularClient
+	converter markdown.Converter
+	projectID string
+	list      *api.TodoList
+	mentions  *utils.Mentions
+	groupIDs  map[string]int64
+}
+
+// push applies the plan and returns the number of todos created
+func (s *syncer) push(plan *syncPlan, remote *remoteList) (int, error) {
+	s.groupIDs = map[string]int64{"": s.list.ID}
+	for _, group := range remote.groups {
+		s.groupIDs[group.Title] = group.ID
+	}
+
+	created := 0
+	for i, item := range plan.final {
+		if _, ok := s.groupIDs[item.Group]; !ok {
+			group, err := s.todoOps.CreateTodoGroup(s.ctx, s.projectID, s.list.ID, api.TodoGroupCreateRequest{Name: item.Group})
+			if err != nil {
+				return created, err
+			}
+			s.groupIDs[item.Group] = group.ID
+		}
+		if item.ID != 0 {
+			continue
+		}
+
+		id, err := s.create(item)
+		if err != nil {
+			return created, err
+		}
+		plan.final[i].ID = id
+		created++
+	}
+
+	for _, u := range plan.updates {
+		if err := s.update(u); err != nil {
+			return created, err
+		}
+	}
+
+	for _, r := range plan.trash {
+		if err := s.client.Activity().TrashRecording(s.ctx, s.projectID, r.item.ID); err != nil {
+			return created, fmt.Errorf("failed to trash todo #%d: %w", r.item.ID, err)
+		}
+	}
+
+	if err := s.reorder(plan.final); err != nil {
+		return created, err
+	}
+	return created, nil
+}
+
+func (s *syncer) create(item checklist.Item) (int64, error) {
+	req := api.TodoCreateRequest{}
+	var err error
+	if req.Content, err = s.converter.MarkdownToRichText(item.Title); err != nil {
+		return 0, fmt.Errorf("failed to convert title: %w", err)
+	}
+	if item.Description != "" {
+		if req.Description, err = s.converter.MarkdownToRichText(item.Description); err != nil {
+			return 0, fmt.Errorf("failed to convert description: %w", err)
+		}
+	}
+	if item.DueOn != "" {
+		due := item.DueOn
+		req.DueOn = &due
+	}
+	if len(item.Assignees) > 0 {
+		if req.AssigneeIDs, err = s.mentions.Resolve(s.ctx, item.Assignees); err != nil {
+			return 0, fmt.Errorf("failed to resolve assignees for %q: %w", item.Title, err)
+		}
+	}
+
+	todo, err := s.todoOps.CreateTodo(s.ctx, s.projectID, s.groupIDs[item.Group], req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create todo %q: %w", item.Title, err)
+	}
+	if item.Completed {
+		if err := s.todoOps.CompleteTodo(s.ctx, s.projectID, todo.ID); err != nil {
+			return 0, fmt.Errorf("failed to complete todo #%d: %w", todo.ID, err)
+		}
+	}
+	return todo.ID, nil
+}
+
+func (s *syncer) update(u syncUpdate) error {
+	id := u.remote.item.ID
+	from, to := u.remote.item, u.local
+
+	assigneesChanged := !equalStrings(to.Assignees, from.Assignees)
+	if to.Title != from.Title || to.Description != from.Description || to.DueOn != from.DueOn || assigneesChanged {
+		// Send every field so nothing is cleared by omission
+		req := api.TodoUpdateRequest{StartsOn: u.remote.todo.StartsOn}
+		var err error
+		if req.Content, err = s.converter.MarkdownToRichText(to.Title); err != nil {
+			return fmt.Errorf("failed to convert title: %w", err)
+		}
+		req.Description = u.remote.todo.Description
+		if to.Description != from.Description {
+			if req.Description, err = s.converter.MarkdownToRichText(to.Description); err != nil {
+				return fmt.Errorf("failed to convert description: %w", err)
+			}
+		}
+		due := to.DueOn
+		req.DueOn = &due
+
+		req.AssigneeIDs = mergeAssigneeIDs(u.remote.todo.Assignees, nil, nil)
+		if assigneesChanged {
+			if req.AssigneeIDs, err = s.mentions.Resolve(s.ctx, to.Assignees); err != nil {
+				return fmt.Errorf("failed to resolve assignees for %q: %w", to.Title, err)
+			}
+		}
+
+		if _, err := s.todoOps.UpdateTodo(s.ctx, s.projectID, id, req); err != nil {
+			return fmt.Errorf("failed to update todo #%d: %w", id, err)
+		}
+	}
+
+	if to.Completed != from.Completed {
+		var err error
+		if to.Completed {
+			err = s.todoOps.CompleteTodo(s.ctx, s.projectID, id)
+		} else {
+			err = s.todoOps.UncompleteTodo(s.ctx, s.projectID, id)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update completion of todo #%d: %w", id, err)
+		}
+	}
+
+	if to.Group != from.Group {
+		if err := s.todoOps.MoveTodo(s.ctx, s.projectID, id, s.groupIDs[to.Group], 1); err != nil {
+			return fmt.Errorf("failed to move todo #%d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// reorder repositions incomplete todos in each list or group to match the
+// planned order
+func (s *syncer) reorder(final []checklist.Item) error {
+	for group, parentID := range s.groupIDs {
+		var desired []int64
+		for _, item := range final {
+			if item.Group == group && !item.Completed {
+				desired = append(desired, item.ID)
+			}
+		}
+		if len(desired) < 2 {
+			continue
+		}
+
+		todos, err := s.todoOps.GetTodos(s.ctx, s.projectID, parentID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch todos: %w", err)
+		}
+		current := make([]int64, len(todos))
+		for i, todo := range todos {
+			current[i] = todo.ID
+		}
+
+		for _, step := range repositionSteps(current, desired) {
+			if err := s.todoOps.RepositionTodo(s.ctx, s.projectID, step.id, step.position); err != nil {
+				return fmt.Errorf("failed to reposition todo #%d: %w", step.id, err)
+			}
+		}
+	}
+	return nil
+}
+
+type repositionStep struct {
+	id       int64
+	position int
+}
+
+// repositionSteps returns the moves (1-based positions) that turn current
+// into desired. IDs missing from either side are left where they are.
+func repositionSteps(current, desired []int64) []repositionStep {
+	present := make(map[int64]bool, len(current))
+	for _, id := range current {
+		present[id] = true
+	}
+
+	order := append([]int64(nil), current...)
+	var steps []repositionStep
+	pos := 0
+	for _, id := range desired {
+		if !present[id] {
+			continue
+		}
+		if order[pos] != id {
+			from := pos
+			for i := pos; i < len(order); i++ {
+				if order[i] == id {
+					from = i
+					break
+				}
+			}
+			copy(order[pos+1:from+1], order[pos:from])
+			order[pos] = id
+			steps = append(steps, repositionStep{id: id, position: pos + 1})
+		}
+		pos++
+	}
+	return steps
+}
+
+func printSyncPlan(plan *syncPlan) {
+	for _, item := range plan.final {
+		if item.ID == 0 {
+			fmt.Printf("  + create %q\n", item.Title)
+		}
+	}
+	for _, u := range plan.updates {
+		fmt.Printf("  ~ update #%d %q\n", u.remote.item.ID, u.local.Title)
+	}
+	for _, r := range plan.trash {
+		fmt.Printf("  - trash #%d %q\n", r.item.ID, r.item.Title)
+	}
+	for _, note := range plan.notes {
+		fmt.Printf("  note: %s\n", note)
+	}
+	for _, conflict := range plan.conflicts {
+		fmt.Printf("  ✗ %s\n", conflict)
+	}
+	fmt.Printf("\n%d changes to pull from Basecamp, %d conflicts\n", plan.pulled, len(plan.conflicts))
+}
+
+func writeSyncFile(path string, list *api.TodoList, remote *remoteList) error {
+	items := make([]checklist.Item, len(remote.todos))
+	for i, t := range remote.todos {
+		items[i] = t.item
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<!-- Synced with the Basecamp todo list %q by bc4 todo sync. Keep the bc4 markers. -->\n\n", list.Title)
+	if err := checklist.Render(&buf, items); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
+// syncStatePath returns the state file kept next to a synced file
+func syncStatePath(path string) string {
+	return filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".bc4sync")
+}
+
+func loadSyncState(path string) (*syncState, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sync state: %w", err)
+	}
+
+	var state syncState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse sync state %s: %w", path, err)
+	}
+	return &state, nil
+}
+
+func newSyncState(projectID string, listID int64, remote *remoteList) *syncState {
+	state := &syncState{
+		ProjectID: projectID,
+		ListID:    listID,
+		SyncedAt:  time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, t := range remote.todos {
+		state.Todos = append(state.Todos, syncedTodo{Item: t.item, UpdatedAt: t.todo.UpdatedAt})
+	}
+	return state
+}
+
+func saveSyncState(path string, state *syncState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write sync state: %w", err)
+	}
+	return nil
+}
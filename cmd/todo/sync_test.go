@@ -0,0 +1,173 @@
+package todo
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+	"github.com/needmore/bc4/internal/checklist"
+	"github.com/needmore/bc4/internal/markdown"
+	"github.com/needmore/bc4/internal/utils"
+)
+
+func syncFixture(items ...checklist.Item) *remoteList {
+	remote := &remoteList{
+		list:   &api.TodoList{ID: 1, Title: "Release"},
+		groups: []api.TodoGroup{{ID: 2, Title: "Backend"}},
+	}
+	for _, item := range items {
+		remote.todos = append(remote.todos, remoteTodo{
+			item: item,
+			todo: api.Todo{ID: item.ID, Title: item.Title, UpdatedAt: "t1"},
+		})
+	}
+	return remote
+}
+
+func stateFor(remote *remoteList) *syncState {
+	return newSyncState("9", remote.list.ID, remote)
+}
+
+func finalIDs(plan *syncPlan) []int64 {
+	var ids []int64
+	for _, item := range plan.final {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func TestPlanSyncFirstRunPullsEverything(t *testing.T) {
+	remote := syncFixture(
+		checklist.Item{ID: 10, Title: "Tag"},
+		checklist.Item{ID: 11, Title: "Migrate", Group: "Backend"},
+	)
+
+	plan := planSync(nil, nil, remote, "", false)
+
+	assert.Equal(t, 2, plan.pulled)
+	assert.Empty(t, plan.updates)
+	assert.Equal(t, []int64{10, 11}, finalIDs(plan))
+}
+
+func TestPlanSyncPushesLocalChanges(t *testing.T) {
+	remote := syncFixture(
+		checklist.Item{ID: 10, Title: "Tag"},
+		checklist.Item{ID: 11, Title: "Announce"},
+	)
+	state := stateFor(remote)
+
+	local := []checklist.Item{
+		{ID: 11, Title: "Announce on the blog", Completed: true},
+		{ID: 10, Title: "Tag"},
+		{Title: "Migrate", Group: "backend"},
+	}
+	plan := planSync(local, state, remote, "", false)
+
+	require.Empty(t, plan.conflicts)
+	require.Len(t, plan.updates, 1)
+	assert.Equal(t, int64(11), plan.updates[0].remote.item.ID)
+	assert.True(t, plan.updates[0].local.Completed)
+	assert.Equal(t, []int64{11, 10, 0}, finalIDs(plan))
+	assert.Equal(t, "Backend", plan.final[2].Group, "headings match existing groups regardless of case")
+}
+
+func TestPlanSyncPullsRemoteChanges(t *testing.T) {
+	remote := syncFixture(
+		checklist.Item{ID: 10, Title: "Tag"},
+		checklist.Item{ID: 11, Title: "Announce"},
+	)
+	state := stateFor(remote)
+	local := []checklist.Item{{ID: 10, Title: "Tag"}, {ID: 11, Title: "Announce"}}
+
+	remote.todos[0].item.Title = "Tag v2"
+	remote.todos[0].todo.UpdatedAt = "t2"
+	remote.todos = append(remote.todos, remoteTodo{item: checklist.Item{ID: 12, Title: "New"}, todo: api.Todo{ID: 12}})
+
+	plan := planSync(local, state, remote, "", false)
+
+	assert.Empty(t, plan.updates)
+	assert.Equal(t, 2, plan.pulled)
+	assert.Equal(t, "Tag v2", plan.final[0].Title)
+	assert.Equal(t, []int64{10, 11, 12}, finalIDs(plan))
+}
+
+func TestPlanSyncConflicts(t *testing.T) {
+	remote := syncFixture(checklist.Item{ID: 10, Title: "Tag"})
+	state := stateFor(remote)
+	remote.todos[0].item.Title = "Tag remotely"
+	remote.todos[0].todo.UpdatedAt = "t2"
+	local := []checklist.Item{{ID: 10, Title: "Tag locally"}}
+
+	plan := planSync(local, state, remote, "", false)
+	assert.Len(t, plan.conflicts, 1)
+
+	plan = planSync(local, state, remote, "local", false)
+	assert.Empty(t, plan.conflicts)
+	require.Len(t, plan.updates, 1)
+	assert.Equal(t, "Tag locally", plan.final[0].Title)
+
+	plan = planSync(local, state, remote, "remote", false)
+	assert.Empty(t, plan.updates)
+	assert.Equal(t, "Tag remotely", plan.final[0].Title)
+}
+
+func TestPlanSyncRemovedLocally(t *testing.T) {
+	remote := syncFixture(checklist.Item{ID: 10, Title: "Tag"}, checklist.Item{ID: 11, Title: "Announce"})
+	state := stateFor(remote)
+	local := []checklist.Item{{ID: 10, Title: "Tag"}}
+
+	plan := planSync(local, state, remote, "", false)
+	assert.Empty(t, plan.trash)
+	assert.Len(t, plan.notes, 1)
+	assert.Equal(t, []int64{10, 11}, finalIDs(plan))
+
+	plan = planSync(local, state, remote, "", true)
+	require.Len(t, plan.trash, 1)
+	assert.Equal(t, int64(11), plan.trash[0].item.ID)
+	assert.Equal(t, []int64{10}, finalIDs(plan))
+}
+
+func TestRepositionSteps(t *testing.T) {
+	steps := repositionSteps([]int64{1, 2, 3, 4}, []int64{3, 1, 2, 4})
+	assert.Equal(t, []repositionStep{{id: 3, position: 1}}, steps)
+
+	steps = repositionSteps([]int64{1, 2, 3}, []int64{3, 2, 1})
+	assert.Equal(t, []repositionStep{{id: 3, position: 1}, {id: 2, position: 2}}, steps)
+
+	assert.Empty(t, repositionSteps([]int64{1, 2}, []int64{1, 2}))
+	assert.Empty(t, repositionSteps([]int64{1, 2}, []int64{9, 1, 2}))
+}
+
+func TestSyncRoundTripsAssignees(t *testing.T) {
+	jane := api.Person{ID: 7, Name: "Jane Doe", EmailAddress: "jane@example.com"}
+	client := mock.NewMockClient()
+	client.Todos = []api.Todo{{ID: 10, Title: "Tag", Assignees: []api.Person{jane}, UpdatedAt: "t1"}}
+	mentions := utils.NewMentions([]api.Person{jane}, nil)
+	list := &api.TodoList{ID: 1, Title: "Release"}
+
+	remote, err := fetchRemoteList(context.Background(), client, markdown.NewConverter(), mentions, "9", list)
+	require.NoError(t, err)
+	require.Len(t, remote.todos, 1)
+	assert.Equal(t, []string{"@jane"}, remote.todos[0].item.Assignees)
+
+	path := filepath.Join(t.TempDir(), "release.md")
+	require.NoError(t, writeSyncFile(path, list, remote))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	local, err := checklist.ParseMarkdown(strings.NewReader(string(data)))
+	require.NoError(t, err)
+
+	plan := planSync(local, stateFor(remote), remote, "", false)
+	assert.Empty(t, plan.updates, "an untouched file with assignees has nothing to push")
+
+	ids, err := mentions.Resolve(context.Background(), local[0].Assignees)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, ids)
+}
@@ -36,6 +36,10 @@ Examples:
 	cmd.AddCommand(newAddCmd(f))
 	cmd.AddCommand(newEditCmd(f))
 	cmd.AddCommand(newMoveCmd(f))
+	cmd.AddCommand(newCopyListCmd(f))
+	cmd.AddCommand(newImportCmd(f))
+	cmd.AddCommand(newSyncCmd(f))
+	cmd.AddCommand(newStatsCmd(f))
 	cmd.AddCommand(newCheckCmd(f))
 	cmd.AddCommand(newUncheckCmd(f))
 	cmd.AddCommand(newCreateListCmd(f))
@@ -44,6 +48,8 @@ Examples:
 	cmd.AddCommand(newRepositionGroupCmd(f))
 	cmd.AddCommand(newAttachmentsCmd(f))
 	cmd.AddCommand(newDownloadAttachmentsCmd(f))
+	cmd.AddCommand(newMineCmd(f))
+	cmd.AddCommand(newDueCmd(f))
 
 	return cmd
 }
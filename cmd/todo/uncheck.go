@@ -2,26 +2,29 @@ package todo
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/needmore/bc4/internal/factory"
-	"github.com/needmore/bc4/internal/parser"
 	"github.com/spf13/cobra"
 )
 
 func newUncheckCmd(f *factory.Factory) *cobra.Command {
 	var accountID string
 	var projectID string
+	filter := &todoFilter{}
 
 	cmd := &cobra.Command{
-		Use:   "uncheck <todo-id or URL>",
-		Short: "Mark a todo as incomplete",
-		Long: `Mark a todo as incomplete.
-
-You can specify the todo using either:
-- A numeric ID (e.g., "12345" or "#12345")
-- A Basecamp URL (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")`,
+		Use:   "uncheck <todo-id or URL>... | - | --list <list>",
+		Short: "Mark one or more todos as incomplete",
+		Long: `Mark one or more todos as incomplete.
+
+You can specify todos using either:
+- Numeric IDs (e.g., "12345" or "#12345")
+- Basecamp URLs (e.g., "https://3.basecamp.com/1234567/buckets/89012345/todos/12345")
+- "-" to read newline-separated IDs or URLs from stdin
+
+Or select completed todos in the current project with --list, --assignee
+and --due-before. When several todos are given, each result is printed
+followed by a summary, and the command exits non-zero if any failed.`,
 		Example: `  # Mark todo #12345 as incomplete
   bc4 todo uncheck 12345
 
@@ -29,8 +32,13 @@ You can specify the todo using either:
   bc4 todo uncheck #12345
 
   # Using a Basecamp URL
-  bc4 todo uncheck "https://3.basecamp.com/1234567/buckets/89012345/todos/12345"`,
-		Args: cobra.ExactArgs(1),
+  bc4 todo uncheck "https://3.basecamp.com/1234567/buckets/89012345/todos/12345"
+
+  # Several at once
+  bc4 todo uncheck 12345 12346
+
+  # Reopen everything completed in a list
+  bc4 todo uncheck --list "Release checklist"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Apply account override if specified
 			if accountID != "" {
@@ -42,36 +50,21 @@ You can specify the todo using either:
 				f = f.WithProject(projectID)
 			}
 
-			return runUncheck(f, args[0], accountID, projectID)
+			return runUncheck(f, args, filter, accountID, projectID)
 		},
 	}
 
 	cmd.Flags().StringVarP(&accountID, "account", "a", "", "Specify account ID")
 	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Specify project ID")
+	filter.addFlags(cmd)
 
 	return cmd
 }
 
-func runUncheck(f *factory.Factory, todoIDStr string, accountIDFlag string, projectIDFlag string) error {
-	// Parse todo ID (handle #123 format and URLs)
-	todoIDStr = strings.TrimPrefix(todoIDStr, "#")
-	todoID, parsedURL, err := parser.ParseArgument(todoIDStr)
+func runUncheck(f *factory.Factory, args []string, filter *todoFilter, accountID, projectID string) error {
+	f, targets, err := collectTodoTargets(f, args, filter, true, accountID, projectID)
 	if err != nil {
-		return fmt.Errorf("invalid todo ID or URL: %s", todoIDStr)
-	}
-
-	// If a URL was parsed, use URL values only if flags weren't provided
-	if parsedURL != nil {
-		if parsedURL.ResourceType != parser.ResourceTypeTodo {
-			return fmt.Errorf("URL is not for a todo: %s", todoIDStr)
-		}
-		// Only use URL values if corresponding flags weren't set
-		if accountIDFlag == "" && parsedURL.AccountID > 0 {
-			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
-		}
-		if projectIDFlag == "" && parsedURL.ProjectID > 0 {
-			f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
-		}
+		return err
 	}
 
 	// Get API client from factory
@@ -81,32 +74,24 @@ func runUncheck(f *factory.Factory, todoIDStr string, accountIDFlag string, proj
 	}
 	todoOps := client.Todos()
 
-	// Get resolved project ID
-	projectID, err := f.ProjectID()
-	if err != nil {
-		return err
-	}
-
-	// Get the todo first to display its title
-	todo, err := todoOps.GetTodo(f.Context(), projectID, todoID)
-	if err != nil {
-		return fmt.Errorf("failed to fetch todo: %w", err)
-	}
-
-	// Check if already incomplete
-	if !todo.Completed {
-		fmt.Printf("○ Todo #%d is already incomplete\n", todoID)
-		return nil
-	}
+	return runBulk(targets, func(t todoTarget) (string, error) {
+		// Get the todo first to display its title
+		todo, err := todoOps.GetTodo(f.Context(), t.projectID, t.todoID)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch todo: %w", err)
+		}
 
-	// Mark as incomplete
-	err = todoOps.UncompleteTodo(f.Context(), projectID, todoID)
-	if err != nil {
-		return fmt.Errorf("failed to uncomplete todo: %w", err)
-	}
+		// Check if already incomplete
+		if !todo.Completed {
+			return fmt.Sprintf("○ Todo #%d is already incomplete", t.todoID), nil
+		}
 
-	// GitHub CLI style: minimal output with confirmation
-	fmt.Printf("○ Reopened #%d: %s\n", todoID, todo.Title)
+		// Mark as incomplete
+		if err := todoOps.UncompleteTodo(f.Context(), t.projectID, t.todoID); err != nil {
+			return "", fmt.Errorf("failed to uncomplete todo: %w", err)
+		}
 
-	return nil
+		// GitHub CLI style: minimal output with confirmation
+		return fmt.Sprintf("○ Reopened #%d: %s", t.todoID, todo.Title), nil
+	})
 }
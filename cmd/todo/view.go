@@ -131,6 +131,14 @@ bc4 todo view 12345 --with-comments`,
 				return encoder.Encode(output)
 			}
 
+			// Load boosts so reactions show up alongside the todo
+			if todo.BoostsCount > 0 {
+				// Boosts are decoration; show the todo without them if they fail
+				if todo.Boosts, err = client.Boosts().ListBoosts(f.Context(), resolvedProjectID, todo.ID); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to fetch boosts: %v\n", err)
+				}
+			}
+
 			// Handle output with comments
 			if withComments {
 				comments, err := client.ListComments(f.Context(), resolvedProjectID, todo.ID)
@@ -138,6 +146,10 @@ bc4 todo view 12345 --with-comments`,
 					return fmt.Errorf("failed to fetch comments: %w", err)
 				}
 
+				if err := utils.LoadCommentBoosts(f.Context(), client.Boosts(), resolvedProjectID, comments); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to fetch boosts: %v\n", err)
+				}
+
 				markdown, err := utils.FormatTodoAsMarkdown(todo, comments)
 				if err != nil {
 					return fmt.Errorf("failed to format todo as markdown: %w", err)
@@ -236,6 +248,11 @@ bc4 todo view 12345 --with-comments`,
 				fmt.Fprintf(&buf, "%s %s\n", labelStyle.Render("Assigned to:"), strings.Join(assigneeNames, ", "))
 			}
 
+			// Show boosts
+			if summary := utils.FormatBoostSummary(todo.Boosts); summary != "" {
+				fmt.Fprintf(&buf, "%s %s\n", labelStyle.Render("Boosts:"), summary)
+			}
+
 			// Show description if present
 			if todo.Description != "" {
 				fmt.Fprintln(&buf)
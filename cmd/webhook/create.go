@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type createOptions struct {
+	accountID  string
+	projectID  string
+	types      []string
+	jsonOutput bool
+}
+
+func newCreateCmd(f *factory.Factory) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create <payload-url>",
+		Short: "Create a webhook in a project",
+		Long: `Create a webhook that delivers project events to the given HTTPS URL.
+
+By default the webhook receives events for every recording type. Use --type
+to subscribe to specific types only. Friendly names (todo, todolist, comment,
+message, document, upload, card, step, question, answer, event, vault) are
+translated to the types Basecamp expects; other values are passed through.`,
+		Example: `  # Receive all events
+  bc4 webhook create https://example.com/basecamp
+
+  # Only todos and comments
+  bc4 webhook create https://example.com/basecamp --type todo,comment
+
+  # Card table activity
+  bc4 webhook create https://example.com/basecamp --type card --type step`,
+		Aliases: []string{"new", "add"},
+		Args:    cmdutil.ExactArgs(1, "payload-url"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runCreate(f, opts, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringSliceVarP(&opts.types, "type", "t", nil, "Recording types to subscribe to (can be used multiple times)")
+
+	return cmd
+}
+
+func runCreate(f *factory.Factory, opts *createOptions, args []string) error {
+	payloadURL := args[0]
+	if err := validatePayloadURL(payloadURL); err != nil {
+		return err
+	}
+
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	webhookOps := client.Webhooks()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	req := api.WebhookCreateRequest{
+		PayloadURL: payloadURL,
+		Types:      parseWebhookTypes(opts.types),
+	}
+
+	webhook, err := webhookOps.CreateWebhook(f.Context(), projectID, req)
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(webhook)
+	}
+
+	fmt.Printf("Created webhook #%d\n", webhook.ID)
+	fmt.Printf("Payload URL: %s\n", webhook.PayloadURL)
+	fmt.Printf("Types:       %s\n", formatTypes(webhook.Types))
+
+	return nil
+}
+
+// validatePayloadURL checks that a payload URL is an absolute HTTPS URL,
+// which Basecamp requires for webhook delivery
+func validatePayloadURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid payload URL: %s", raw)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("payload URL must use https: %s", raw)
+	}
+	return nil
+}
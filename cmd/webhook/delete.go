@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+type deleteOptions struct {
+	accountID   string
+	projectID   string
+	skipConfirm bool
+}
+
+func newDeleteCmd(f *factory.Factory) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <webhook-id|URL>",
+		Short: "Delete a webhook",
+		Long:  `Delete a webhook. Basecamp stops delivering events to its payload URL immediately. This operation cannot be undone.`,
+		Example: `  # Delete a webhook (with confirmation prompt)
+  bc4 webhook delete 12345
+
+  # Delete without confirmation
+  bc4 webhook delete 12345 --yes`,
+		Aliases: []string{"rm", "remove"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(f, opts, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().BoolVarP(&opts.skipConfirm, "yes", "y", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runDelete(f *factory.Factory, opts *deleteOptions, args []string) error {
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, webhookID, err := resolveWebhookArg(f, args[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	webhookOps := client.Webhooks()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	// Get the webhook first to show what will be deleted
+	webhook, err := webhookOps.GetWebhook(f.Context(), projectID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if !opts.skipConfirm {
+		var confirm bool
+		if err := huh.NewConfirm().
+			Title(fmt.Sprintf("Delete webhook #%d?", webhookID)).
+			Description(fmt.Sprintf("Delivers to %s", webhook.PayloadURL)).
+			Affirmative("Delete").
+			Negative("Cancel").
+			Value(&confirm).
+			Run(); err != nil {
+			return err
+		}
+
+		if !confirm {
+			fmt.Println("Canceled")
+			return nil
+		}
+	}
+
+	if err := webhookOps.DeleteWebhook(f.Context(), projectID, webhookID); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Deleted webhook #%d\n", webhookID)
+	return nil
+}
@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type editOptions struct {
+	accountID  string
+	projectID  string
+	payloadURL string
+	types      []string
+	active     bool
+	inactive   bool
+	jsonOutput bool
+}
+
+func newEditCmd(f *factory.Factory) *cobra.Command {
+	opts := &editOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit <webhook-id|URL>",
+		Short: "Update a webhook",
+		Long: `Update a webhook's payload URL, subscribed types, or active status.
+
+Only the flags you pass are changed. Passing --type replaces the full list
+of subscribed types.`,
+		Example: `  # Change the payload URL
+  bc4 webhook edit 12345 --url https://example.com/new-endpoint
+
+  # Subscribe to messages and documents only
+  bc4 webhook edit 12345 --type message,document
+
+  # Temporarily disable a webhook
+  bc4 webhook edit 12345 --inactive
+
+  # Re-enable it
+  bc4 webhook edit 12345 --active`,
+		Aliases: []string{"update"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runEdit(f, opts, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringVar(&opts.payloadURL, "url", "", "New payload URL")
+	cmd.Flags().StringSliceVarP(&opts.types, "type", "t", nil, "Recording types to subscribe to (replaces existing types)")
+	cmd.Flags().BoolVar(&opts.active, "active", false, "Activate the webhook")
+	cmd.Flags().BoolVar(&opts.inactive, "inactive", false, "Deactivate the webhook")
+	cmd.MarkFlagsMutuallyExclusive("active", "inactive")
+
+	return cmd
+}
+
+func runEdit(f *factory.Factory, opts *editOptions, args []string) error {
+	req, err := buildUpdateRequest(opts)
+	if err != nil {
+		return err
+	}
+
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, webhookID, err := resolveWebhookArg(f, args[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	webhookOps := client.Webhooks()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	webhook, err := webhookOps.UpdateWebhook(f.Context(), projectID, webhookID, req)
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(webhook)
+	}
+
+	fmt.Printf("Updated webhook #%d\n", webhook.ID)
+	return nil
+}
+
+// buildUpdateRequest converts edit flags into an update request,
+// rejecting invocations that would not change anything
+func buildUpdateRequest(opts *editOptions) (api.WebhookUpdateRequest, error) {
+	req := api.WebhookUpdateRequest{}
+
+	if opts.payloadURL != "" {
+		if err := validatePayloadURL(opts.payloadURL); err != nil {
+			return req, err
+		}
+		req.PayloadURL = opts.payloadURL
+	}
+
+	req.Types = parseWebhookTypes(opts.types)
+
+	if opts.active || opts.inactive {
+		active := opts.active
+		req.Active = &active
+	}
+
+	if req.PayloadURL == "" && len(req.Types) == 0 && req.Active == nil {
+		return req, fmt.Errorf("nothing to update: use --url, --type, --active, or --inactive")
+	}
+
+	return req, nil
+}
@@ -0,0 +1,165 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/ui/tableprinter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type listOptions struct {
+	accountID  string
+	projectID  string
+	types      []string
+	jsonOutput bool
+}
+
+func newListCmd(f *factory.Factory) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List webhooks in a project",
+		Long: `List all webhooks configured for the current project.
+
+Shows the payload URL, the recording types each webhook is subscribed to,
+and whether it is active. Use --type to only show webhooks that receive
+events for the given types.`,
+		Example: `  # List webhooks in the current project
+  bc4 webhook list
+
+  # Only webhooks that receive todo or comment events
+  bc4 webhook list --type todo,comment
+
+  # Output as JSON
+  bc4 webhook list --json`,
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runList(f, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+	cmd.Flags().StringSliceVarP(&opts.types, "type", "t", nil, "Filter by type (e.g., todo, comment, message, card)")
+
+	return cmd
+}
+
+func runList(f *factory.Factory, opts *listOptions) error {
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	webhookOps := client.Webhooks()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := webhookOps.ListWebhooks(f.Context(), projectID)
+	if err != nil {
+		return err
+	}
+
+	webhooks = filterWebhooksByType(webhooks, parseWebhookTypes(opts.types))
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(webhooks)
+	}
+
+	if len(webhooks) == 0 {
+		fmt.Println("No webhooks found in this project.")
+		return nil
+	}
+
+	table := tableprinter.New(os.Stdout)
+
+	if table.IsTTY() {
+		table.AddHeader("ID", "PAYLOAD URL", "TYPES", "STATUS", "UPDATED")
+	} else {
+		table.AddHeader("ID", "PAYLOAD URL", "TYPES", "ACTIVE", "UPDATED")
+	}
+
+	now := time.Now()
+
+	for _, w := range webhooks {
+		state := "active"
+		if !w.Active {
+			state = "inactive"
+		}
+		table.AddIDField(strconv.FormatInt(w.ID, 10), state)
+		table.AddField(w.PayloadURL)
+		table.AddField(formatTypes(w.Types))
+
+		if table.IsTTY() {
+			if w.Active {
+				table.AddColorField("active", "active")
+			} else {
+				table.AddColorField("inactive", "inactive")
+			}
+		} else {
+			table.AddField(strconv.FormatBool(w.Active))
+		}
+
+		table.AddTimeField(now, w.UpdatedAt)
+		table.EndRow()
+	}
+
+	return table.Render()
+}
+
+// filterWebhooksByType keeps webhooks subscribed to at least one of the given types.
+// Webhooks without explicit types receive every event and always match.
+func filterWebhooksByType(webhooks []api.Webhook, types []string) []api.Webhook {
+	if len(types) == 0 {
+		return webhooks
+	}
+
+	var filtered []api.Webhook
+	for _, w := range webhooks {
+		if len(w.Types) == 0 {
+			filtered = append(filtered, w)
+			continue
+		}
+		for _, t := range w.Types {
+			if containsType(types, t) {
+				filtered = append(filtered, w)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+func containsType(types []string, t string) bool {
+	for _, candidate := range types {
+		if strings.EqualFold(candidate, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatTypes renders a webhook's type list for display
+func formatTypes(types []string) string {
+	if len(types) == 0 {
+		return "all"
+	}
+	return strings.Join(types, ", ")
+}
@@ -0,0 +1,316 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+// maxPayloadSize caps the size of an incoming webhook body
+const maxPayloadSize = 5 << 20
+
+type listenOptions struct {
+	host   string
+	port   int
+	path   string
+	format string
+	exec   string
+}
+
+func newListenCmd(f *factory.Factory) *cobra.Command {
+	opts := &listenOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "listen",
+		Short: "Run a local receiver for webhook events",
+		Long: `Start a local HTTP server that accepts Basecamp webhook deliveries.
+
+Each event is printed as it arrives, either as a readable one-line summary
+(--format pretty) or as newline-delimited JSON (--format json) that can be
+piped into other tools.
+
+With --exec, the given shell command is run once per event with the raw JSON
+payload on stdin. The following environment variables are also set:
+
+  BC4_WEBHOOK_KIND            Event kind (e.g., todo_created)
+  BC4_WEBHOOK_RECORDING_ID    ID of the recording the event is about
+  BC4_WEBHOOK_RECORDING_TYPE  Recording type (e.g., Todo, Comment)
+  BC4_WEBHOOK_PROJECT_ID      ID of the project (bucket)
+
+Events are processed one at a time in the order they arrive. Basecamp
+requires an HTTPS payload URL, so expose the receiver through a tunnel or
+reverse proxy and register that URL with 'bc4 webhook create'.`,
+		Example: `  # Print events as they arrive
+  bc4 webhook listen --port 8080
+
+  # Emit NDJSON for other tools
+  bc4 webhook listen --port 8080 --format json | jq .kind
+
+  # Run a script for every event
+  bc4 webhook listen --port 8080 --exec ./on-basecamp-event.sh`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runListen(cmd.Context(), opts)
+		},
+	}
+
+	// The receiver never calls the Basecamp API
+	cmdutil.DisableAuthCheck(cmd)
+
+	cmd.Flags().StringVar(&opts.host, "host", "127.0.0.1", "Interface to listen on")
+	cmd.Flags().IntVar(&opts.port, "port", 8080, "Port to listen on")
+	cmd.Flags().StringVar(&opts.path, "path", "/", "URL path that accepts deliveries")
+	cmd.Flags().StringVarP(&opts.format, "format", "f", "pretty", "Output format: pretty or json")
+	cmd.Flags().StringVar(&opts.exec, "exec", "", "Shell command to run for each event (payload on stdin)")
+
+	return cmd
+}
+
+func runListen(ctx context.Context, opts *listenOptions) error {
+	if opts.format != "pretty" && opts.format != "json" {
+		return fmt.Errorf("unknown output format: %s (use pretty or json)", opts.format)
+	}
+	if opts.port <= 0 || opts.port > 65535 {
+		return fmt.Errorf("invalid port: %d", opts.port)
+	}
+	if !strings.HasPrefix(opts.path, "/") {
+		opts.path = "/" + opts.path
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	receiver := newReceiver(os.Stdout, os.Stderr, opts.format, opts.exec)
+	defer receiver.Close()
+
+	mux := http.NewServeMux()
+	mux.Handle(opts.path, receiver)
+
+	addr := net.JoinHostPort(opts.host, strconv.Itoa(opts.port))
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	// Status messages go to stderr so NDJSON on stdout stays machine-readable
+	fmt.Fprintf(os.Stderr, "Listening for Basecamp webhooks on http://%s%s\n", addr, opts.path)
+	fmt.Fprintln(os.Stderr, "Press Ctrl+C to stop")
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+
+	select {
+	case <-ctx.Done():
+		fmt.Fprintln(os.Stderr, "\nStopping listener...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			// Handlers still running after the timeout are cut off here;
+			// the receiver refuses anything they try to queue afterwards
+			_ = server.Close()
+			return err
+		}
+		return nil
+	case err := <-serveErr:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// delivery is a single webhook payload queued for processing
+type delivery struct {
+	raw   []byte
+	event api.WebhookEvent
+}
+
+// receiver accepts webhook deliveries over HTTP and processes them in order
+type receiver struct {
+	out     io.Writer
+	errOut  io.Writer
+	format  string
+	execCmd string
+
+	// mu guards closed so no handler sends on queue after Close
+	mu     sync.RWMutex
+	closed bool
+	queue  chan delivery
+	done   chan struct{}
+}
+
+func newReceiver(out, errOut io.Writer, format, execCmd string) *receiver {
+	r := &receiver{
+		out:     out,
+		errOut:  errOut,
+		format:  format,
+		execCmd: execCmd,
+		queue:   make(chan delivery, 100),
+		done:    make(chan struct{}),
+	}
+	go r.process()
+	return r
+}
+
+// ServeHTTP validates and enqueues a delivery, acknowledging it immediately
+// so that slow --exec commands don't cause Basecamp to time out and retry
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxPayloadSize+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxPayloadSize {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var event api.WebhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	// Normalize to a single line for NDJSON output and stdin
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, body); err != nil {
+		http.Error(w, "invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+
+	// Never block the handler: when the queue is full or the listener is
+	// stopping, ask Basecamp to retry the delivery later
+	if !r.enqueue(delivery{raw: compact.Bytes(), event: event}) {
+		fmt.Fprintf(r.errOut, "queue full or stopping, asked Basecamp to retry event %d (%s)\n", event.ID, event.Kind)
+		w.Header().Set("Retry-After", "30")
+		http.Error(w, "busy, retry later", http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// enqueue queues a delivery without blocking, reporting false when the
+// queue is full or already closed
+func (r *receiver) enqueue(d delivery) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return false
+	}
+	select {
+	case r.queue <- d:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops accepting work and waits for queued deliveries to finish
+func (r *receiver) Close() {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.queue)
+	}
+	r.mu.Unlock()
+	<-r.done
+}
+
+func (r *receiver) process() {
+	defer close(r.done)
+	for d := range r.queue {
+		r.handle(d)
+	}
+}
+
+func (r *receiver) handle(d delivery) {
+	if r.format == "json" {
+		fmt.Fprintf(r.out, "%s\n", d.raw)
+	} else {
+		fmt.Fprintln(r.out, formatEvent(d.event))
+	}
+
+	if r.execCmd == "" {
+		return
+	}
+
+	cmd := exec.Command("sh", "-c", r.execCmd)
+	cmd.Stdin = bytes.NewReader(d.raw)
+	cmd.Stdout = r.out
+	cmd.Stderr = r.errOut
+	cmd.Env = append(os.Environ(),
+		"BC4_WEBHOOK_KIND="+d.event.Kind,
+		"BC4_WEBHOOK_RECORDING_ID="+strconv.FormatInt(d.event.Recording.ID, 10),
+		"BC4_WEBHOOK_RECORDING_TYPE="+d.event.Recording.Type,
+		"BC4_WEBHOOK_PROJECT_ID="+strconv.FormatInt(d.event.Recording.Bucket.ID, 10),
+	)
+
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(r.errOut, "exec failed for event %d (%s): %v\n", d.event.ID, d.event.Kind, err)
+	}
+}
+
+// formatEvent renders a webhook event as a compact one-line summary
+func formatEvent(e api.WebhookEvent) string {
+	timestamp := e.CreatedAt
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	line := fmt.Sprintf("[%s] %s", timestamp.Local().Format("15:04:05"), e.Kind)
+
+	title := e.Recording.Title
+	if runes := []rune(title); len(runes) > 80 {
+		title = string(runes[:77]) + "..."
+	}
+	if title != "" {
+		line = fmt.Sprintf("%s: %s", line, title)
+	} else if e.Recording.Type != "" {
+		line = fmt.Sprintf("%s: %s #%d", line, e.Recording.Type, e.Recording.ID)
+	}
+
+	if e.Recording.Bucket.Name != "" {
+		line = fmt.Sprintf("%s (in %s)", line, e.Recording.Bucket.Name)
+	}
+
+	if e.Creator.Name != "" {
+		line = fmt.Sprintf("%s by %s", line, e.Creator.Name)
+	}
+
+	return line
+}
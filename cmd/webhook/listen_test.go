@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+const samplePayload = `{
+  "id": 9007199254741210,
+  "kind": "todo_created",
+  "details": {},
+  "created_at": "2026-01-15T10:30:00Z",
+  "recording": {
+    "id": 9007199254741208,
+    "status": "active",
+    "type": "Todo",
+    "title": "Ship the release",
+    "bucket": {"id": 2085958499, "name": "Launch", "type": "Project"}
+  },
+  "creator": {"id": 1049715914, "name": "Victor Cooper"}
+}`
+
+func TestReceiverServeHTTP(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		body       string
+		format     string
+		wantStatus int
+		wantOutput []string
+	}{
+		{
+			name:       "pretty output",
+			method:     http.MethodPost,
+			body:       samplePayload,
+			format:     "pretty",
+			wantStatus: http.StatusOK,
+			wantOutput: []string{"todo_created: Ship the release", "(in Launch)", "by Victor Cooper"},
+		},
+		{
+			name:       "ndjson output",
+			method:     http.MethodPost,
+			body:       samplePayload,
+			format:     "json",
+			wantStatus: http.StatusOK,
+			wantOutput: []string{`{"id":9007199254741210,"kind":"todo_created"`},
+		},
+		{
+			name:       "rejects GET",
+			method:     http.MethodGet,
+			format:     "pretty",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "rejects invalid JSON",
+			method:     http.MethodPost,
+			body:       "not json",
+			format:     "pretty",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out, errOut bytes.Buffer
+			r := newReceiver(&out, &errOut, tt.format, "")
+
+			req := httptest.NewRequest(tt.method, "/", strings.NewReader(tt.body))
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+			r.Close()
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			for _, want := range tt.wantOutput {
+				assert.Contains(t, out.String(), want)
+			}
+			if tt.wantStatus != http.StatusOK {
+				assert.Empty(t, out.String())
+			}
+			if tt.format == "json" && tt.wantStatus == http.StatusOK {
+				assert.Equal(t, 1, strings.Count(out.String(), "\n"), "NDJSON must be one line per event")
+			}
+		})
+	}
+}
+
+func TestReceiverExec(t *testing.T) {
+	var out, errOut bytes.Buffer
+	r := newReceiver(&out, &errOut, "json", `printf '%s ' "$BC4_WEBHOOK_KIND"; wc -c | tr -d ' '`)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(samplePayload))
+	r.ServeHTTP(httptest.NewRecorder(), req)
+	r.Close()
+
+	assert.Empty(t, errOut.String())
+	assert.Contains(t, out.String(), "todo_created ")
+}
+
+func TestReceiverQueueFull(t *testing.T) {
+	var errOut bytes.Buffer
+	// No consumer and no buffer, so the queue is always full
+	r := &receiver{out: &bytes.Buffer{}, errOut: &errOut, queue: make(chan delivery)}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(samplePayload)))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Equal(t, "30", rec.Header().Get("Retry-After"))
+	assert.Contains(t, errOut.String(), "queue full")
+}
+
+func TestReceiverRefusesAfterClose(t *testing.T) {
+	var errOut bytes.Buffer
+	r := newReceiver(&bytes.Buffer{}, &errOut, "json", "")
+	r.Close()
+
+	rec := httptest.NewRecorder()
+	assert.NotPanics(t, func() {
+		r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(samplePayload)))
+	})
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestFormatEvent(t *testing.T) {
+	event := api.WebhookEvent{
+		Kind:      "comment_created",
+		CreatedAt: time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC),
+		Recording: api.Recording{ID: 42, Type: "Comment"},
+		Creator:   api.Person{Name: "Annie Bryan"},
+	}
+
+	line := formatEvent(event)
+	assert.Contains(t, line, "comment_created: Comment #42")
+	assert.Contains(t, line, "by Annie Bryan")
+}
+
+func TestFormatEventTruncatesByRune(t *testing.T) {
+	event := api.WebhookEvent{
+		Kind:      "todo_created",
+		CreatedAt: time.Date(2026, 1, 15, 10, 30, 0, 0, time.UTC),
+		Recording: api.Recording{Title: strings.Repeat("é", 100)},
+	}
+
+	line := formatEvent(event)
+	assert.True(t, utf8.ValidString(line))
+	assert.Contains(t, line, strings.Repeat("é", 77)+"...")
+}
@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// parseWebhookTypes expands comma-separated type filters into API type names
+func parseWebhookTypes(input []string) []string {
+	var types []string
+	for _, s := range input {
+		for _, part := range strings.Split(s, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			types = append(types, api.NormalizeWebhookType(part))
+		}
+	}
+	return types
+}
+
+// resolveWebhookArg parses a webhook ID or URL and applies any account/project
+// overrides carried by the URL to the factory
+func resolveWebhookArg(f *factory.Factory, arg string) (*factory.Factory, int64, error) {
+	webhookID, parsedURL, err := parser.ParseArgument(arg)
+	if err != nil {
+		return f, 0, fmt.Errorf("invalid webhook ID or URL: %s", arg)
+	}
+
+	if parsedURL != nil {
+		if parsedURL.ResourceType != parser.ResourceTypeWebhook {
+			return f, 0, fmt.Errorf("URL is not a webhook URL: %s", arg)
+		}
+		if parsedURL.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+		}
+		if parsedURL.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+		}
+	}
+
+	return f, webhookID, nil
+}
@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type viewOptions struct {
+	accountID  string
+	projectID  string
+	jsonOutput bool
+}
+
+func newViewCmd(f *factory.Factory) *cobra.Command {
+	opts := &viewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "view <webhook-id|URL>",
+		Short: "View a webhook and its recent deliveries",
+		Long: `View the configuration of a webhook along with its most recent
+delivery attempts and the response codes returned by the payload URL.`,
+		Example: `  # View a webhook
+  bc4 webhook view 12345
+
+  # Output as JSON
+  bc4 webhook view 12345 --json`,
+		Aliases: []string{"show"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.jsonOutput = viper.GetBool("json")
+			return runView(f, opts, args)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.accountID, "account", "a", "", "Specify account ID")
+	cmd.Flags().StringVarP(&opts.projectID, "project", "p", "", "Specify project ID")
+
+	return cmd
+}
+
+func runView(f *factory.Factory, opts *viewOptions, args []string) error {
+	f = f.ApplyOverrides(opts.accountID, opts.projectID)
+
+	f, webhookID, err := resolveWebhookArg(f, args[0])
+	if err != nil {
+		return err
+	}
+
+	client, err := f.ApiClient()
+	if err != nil {
+		return err
+	}
+	webhookOps := client.Webhooks()
+
+	projectID, err := f.ProjectID()
+	if err != nil {
+		return err
+	}
+
+	webhook, err := webhookOps.GetWebhook(f.Context(), projectID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	if opts.jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(webhook)
+	}
+
+	status := "active"
+	if !webhook.Active {
+		status = "inactive"
+	}
+
+	fmt.Printf("Webhook #%d\n\n", webhook.ID)
+	fmt.Printf("Payload URL: %s\n", webhook.PayloadURL)
+	fmt.Printf("Types:       %s\n", formatTypes(webhook.Types))
+	fmt.Printf("Status:      %s\n", status)
+	fmt.Printf("Created:     %s\n", webhook.CreatedAt.Format("Jan 2, 2006 at 3:04 PM"))
+	fmt.Printf("Updated:     %s\n", webhook.UpdatedAt.Format("Jan 2, 2006 at 3:04 PM"))
+
+	if len(webhook.RecentDeliveries) > 0 {
+		fmt.Printf("\nRecent deliveries (%d):\n", len(webhook.RecentDeliveries))
+		for _, d := range webhook.RecentDeliveries {
+			line := fmt.Sprintf("  %s  %d", d.CreatedAt.Format("2006-01-02 15:04:05"), d.Response.Code)
+			if d.Response.Message != "" {
+				line += " " + d.Response.Message
+			}
+			fmt.Println(line)
+		}
+	}
+
+	return nil
+}
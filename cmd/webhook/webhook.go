@@ -0,0 +1,42 @@
+package webhook
+
+import (
+	"github.com/needmore/bc4/internal/cmdutil"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/spf13/cobra"
+)
+
+// NewWebhookCmd creates the webhook command
+func NewWebhookCmd(f *factory.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Manage project webhooks and receive webhook events",
+		Long: `Work with Basecamp project webhooks.
+
+Webhooks let Basecamp push events (new todos, comments, messages, card moves,
+and more) to a URL as they happen, instead of polling with 'bc4 activity watch'.
+Each webhook can be limited to specific recording types.
+
+Use 'bc4 webhook listen' to run a local receiver that prints incoming events
+or hands them to a command of your choice.`,
+		Example: `  bc4 webhook list                                    # List webhooks in project
+  bc4 webhook create https://example.com/hook --type todo,comment
+  bc4 webhook edit 123 --inactive                     # Disable a webhook
+  bc4 webhook delete 123                              # Delete a webhook
+  bc4 webhook listen --port 8080                      # Receive events locally`,
+		Aliases: []string{"webhooks", "hook", "hooks"},
+	}
+
+	// Enable suggestions for subcommand typos
+	cmdutil.EnableSuggestions(cmd)
+
+	// Add subcommands
+	cmd.AddCommand(newListCmd(f))
+	cmd.AddCommand(newViewCmd(f))
+	cmd.AddCommand(newCreateCmd(f))
+	cmd.AddCommand(newEditCmd(f))
+	cmd.AddCommand(newDeleteCmd(f))
+	cmd.AddCommand(newListenCmd(f))
+
+	return cmd
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhookCmd(t *testing.T) {
+	f := factory.New()
+	cmd := NewWebhookCmd(f)
+
+	assert.Equal(t, "webhook", cmd.Use)
+	assert.Contains(t, cmd.Aliases, "webhooks")
+
+	subcommands := []string{"list", "view", "create", "edit", "delete", "listen"}
+	for _, subcmd := range subcommands {
+		t.Run("has_"+subcmd+"_subcommand", func(t *testing.T) {
+			found := false
+			for _, c := range cmd.Commands() {
+				if c.Name() == subcmd {
+					found = true
+					break
+				}
+			}
+			assert.True(t, found, "Expected to find subcommand: %s", subcmd)
+		})
+	}
+}
+
+func TestParseWebhookTypes(t *testing.T) {
+	types := parseWebhookTypes([]string{"todo,comment", " card ", "Kanban::Step", ""})
+	assert.Equal(t, []string{"Todo", "Comment", "Kanban::Card", "Kanban::Step"}, types)
+}
+
+func TestFilterWebhooksByType(t *testing.T) {
+	webhooks := []api.Webhook{
+		{ID: 1, Types: []string{"Todo", "Comment"}},
+		{ID: 2, Types: []string{"Message"}},
+		{ID: 3},
+	}
+
+	filtered := filterWebhooksByType(webhooks, []string{"Comment"})
+	assert.Len(t, filtered, 2)
+	assert.Equal(t, int64(1), filtered[0].ID)
+	assert.Equal(t, int64(3), filtered[1].ID)
+
+	assert.Len(t, filterWebhooksByType(webhooks, nil), 3)
+}
+
+func TestBuildUpdateRequest(t *testing.T) {
+	_, err := buildUpdateRequest(&editOptions{})
+	assert.Error(t, err)
+
+	_, err = buildUpdateRequest(&editOptions{payloadURL: "http://example.com"})
+	assert.Error(t, err)
+
+	req, err := buildUpdateRequest(&editOptions{inactive: true, types: []string{"todo"}})
+	assert.NoError(t, err)
+	if assert.NotNil(t, req.Active) {
+		assert.False(t, *req.Active)
+	}
+	assert.Equal(t, []string{"Todo"}, req.Types)
+}
@@ -19,6 +19,9 @@ type Event struct {
 	Recording     Recording `json:"recording"`
 	Creator       Person    `json:"creator"`
 	Bucket        Bucket    `json:"bucket"`
+	// Details holds action-specific data, such as the columns a card moved
+	// between. Its shape varies by action.
+	Details map[string]any `json:"details,omitempty"`
 }
 
 // Recording represents a Basecamp recording (generic content item)
@@ -216,3 +219,14 @@ func (c *Client) GetRecording(ctx context.Context, projectID string, recordingID
 
 	return &recording, nil
 }
+
+// TrashRecording moves any recording (todo, message, card, ...) to the trash
+func (c *Client) TrashRecording(ctx context.Context, projectID string, recordingID int64) error {
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/status/trashed.json", projectID, recordingID)
+
+	if err := c.Put(path, nil, nil); err != nil {
+		return fmt.Errorf("failed to trash recording: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// Assignment types reported by the assignment endpoints
+const (
+	AssignmentTypeTodo     = "Todo"
+	AssignmentTypeCardStep = "Kanban::Step"
+)
+
+// Assignment represents a todo or card step assigned to someone, as returned
+// by the account-wide assignment reports
+type Assignment struct {
+	ID            int64    `json:"id"`
+	Type          string   `json:"type"`
+	Content       string   `json:"content"`
+	AppURL        string   `json:"app_url"`
+	StartsOn      *string  `json:"starts_on,omitempty"`
+	DueOn         *string  `json:"due_on,omitempty"`
+	Completed     bool     `json:"completed"`
+	CommentsCount int      `json:"comments_count"`
+	Assignees     []Person `json:"assignees"`
+	Bucket        Bucket   `json:"bucket"`
+	Parent        *Parent  `json:"parent,omitempty"`
+}
+
+// MyAssignments holds the current user's open assignments
+type MyAssignments struct {
+	Priorities    []Assignment `json:"priorities"`
+	NonPriorities []Assignment `json:"non_priorities"`
+}
+
+// All returns priority and non-priority assignments in a single slice
+func (m *MyAssignments) All() []Assignment {
+	all := make([]Assignment, 0, len(m.Priorities)+len(m.NonPriorities))
+	all = append(all, m.Priorities...)
+	return append(all, m.NonPriorities...)
+}
+
+// PersonAssignmentsReport is the todos-assigned report for a single person
+type PersonAssignmentsReport struct {
+	Person    Person       `json:"person"`
+	GroupedBy string       `json:"grouped_by"`
+	Todos     []Assignment `json:"todos"`
+}
+
+// GetMyAssignments returns the open todos and card steps assigned to the
+// current user across all projects
+func (c *Client) GetMyAssignments(ctx context.Context) (*MyAssignments, error) {
+	var assignments MyAssignments
+
+	if err := c.Get("/my/assignments.json", &assignments); err != nil {
+		return nil, fmt.Errorf("failed to get assignments: %w", err)
+	}
+
+	return &assignments, nil
+}
+
+// GetPersonAssignments returns the open todos assigned to a person across all projects
+func (c *Client) GetPersonAssignments(ctx context.Context, personID int64) ([]Assignment, error) {
+	var report PersonAssignmentsReport
+	path := fmt.Sprintf("/reports/todos/assigned/%d.json", personID)
+
+	if err := c.Get(path, &report); err != nil {
+		return nil, fmt.Errorf("failed to get assigned todos: %w", err)
+	}
+
+	for i := range report.Todos {
+		if report.Todos[i].Type == "" {
+			report.Todos[i].Type = AssignmentTypeTodo
+		}
+	}
+
+	return report.Todos, nil
+}
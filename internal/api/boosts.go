@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Boost represents a short reaction (usually an emoji) left on a recording
+type Boost struct {
+	ID        int64     `json:"id"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+	Booster   Person    `json:"booster"`
+	Recording struct {
+		ID    int64  `json:"id"`
+		Title string `json:"title"`
+		Type  string `json:"type"`
+		URL   string `json:"url"`
+	} `json:"recording"`
+}
+
+// BoostCreateRequest represents the payload for boosting a recording
+type BoostCreateRequest struct {
+	Content string `json:"content"`
+}
+
+// ListBoosts returns all boosts on a recording
+func (c *Client) ListBoosts(ctx context.Context, projectID string, recordingID int64) ([]Boost, error) {
+	var boosts []Boost
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/boosts.json", projectID, recordingID)
+
+	pr := NewPaginatedRequest(c)
+	if err := pr.GetAll(path, &boosts); err != nil {
+		return nil, fmt.Errorf("failed to list boosts: %w", err)
+	}
+
+	return boosts, nil
+}
+
+// GetBoost returns a specific boost
+func (c *Client) GetBoost(ctx context.Context, projectID string, boostID int64) (*Boost, error) {
+	var boost Boost
+	path := fmt.Sprintf("/buckets/%s/boosts/%d.json", projectID, boostID)
+
+	if err := c.Get(path, &boost); err != nil {
+		return nil, fmt.Errorf("failed to get boost: %w", err)
+	}
+
+	return &boost, nil
+}
+
+// CreateBoost boosts a recording as the current user
+func (c *Client) CreateBoost(ctx context.Context, projectID string, recordingID int64, req BoostCreateRequest) (*Boost, error) {
+	var boost Boost
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/boosts.json", projectID, recordingID)
+
+	if err := c.Post(path, req, &boost); err != nil {
+		return nil, fmt.Errorf("failed to create boost: %w", err)
+	}
+
+	return &boost, nil
+}
+
+// DeleteBoost removes a boost
+func (c *Client) DeleteBoost(ctx context.Context, projectID string, boostID int64) error {
+	path := fmt.Sprintf("/buckets/%s/boosts/%d.json", projectID, boostID)
+
+	if err := c.Delete(path); err != nil {
+		return fmt.Errorf("failed to delete boost: %w", err)
+	}
+
+	return nil
+}
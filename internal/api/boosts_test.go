@@ -0,0 +1,45 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBoost(t *testing.T) {
+	var received BoostCreateRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/123456/buckets/789/recordings/42/boosts.json", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&received)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"id": 7,
+			"content": "🎉",
+			"booster": {"id": 1, "name": "Jane"},
+			"recording": {"id": 42, "type": "Message"}
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	boost, err := client.CreateBoost(context.Background(), "789", 42, BoostCreateRequest{Content: "🎉"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "🎉", received.Content)
+	assert.Equal(t, int64(7), boost.ID)
+	assert.Equal(t, "Jane", boost.Booster.Name)
+	assert.Equal(t, int64(42), boost.Recording.ID)
+}
@@ -3,10 +3,14 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 )
 
+// ErrNoCardTables is returned when a project has no card table
+var ErrNoCardTables = errors.New("no card tables found for project")
+
 // CardTable represents a Basecamp card table (kanban board)
 type CardTable struct {
 	ID          int64     `json:"id"`
@@ -23,17 +27,18 @@ type CardTable struct {
 
 // Column represents a column in a card table
 type Column struct {
-	ID         int64        `json:"id"`
-	Title      string       `json:"title"`
-	Name       string       `json:"name"`
-	Type       string       `json:"type"`
-	Color      string       `json:"color,omitempty"`
-	Status     string       `json:"status"`
-	OnHold     OnHoldStatus `json:"on_hold"`
-	CardsCount int          `json:"cards_count"`
-	CardsURL   string       `json:"cards_url"`
-	CreatedAt  time.Time    `json:"created_at"`
-	UpdatedAt  time.Time    `json:"updated_at"`
+	ID          int64        `json:"id"`
+	Title       string       `json:"title"`
+	Name        string       `json:"name"`
+	Type        string       `json:"type"`
+	Description string       `json:"description,omitempty"`
+	Color       string       `json:"color,omitempty"`
+	Status      string       `json:"status"`
+	OnHold      OnHoldStatus `json:"on_hold"`
+	CardsCount  int          `json:"cards_count"`
+	CardsURL    string       `json:"cards_url"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
 }
 
 // OnHoldStatus represents the on_hold status of a column
@@ -60,6 +65,7 @@ type Card struct {
 	UpdatedAt     time.Time `json:"updated_at"`
 	Parent        *Column   `json:"parent"`
 	URL           string    `json:"url"`
+	AppURL        string    `json:"app_url"`
 	IsOnHold      bool      `json:"-"` // Set programmatically, not from API
 }
 
@@ -78,10 +84,11 @@ type Step struct {
 
 // CardCreateRequest represents the payload for creating a new card
 type CardCreateRequest struct {
-	Title   string  `json:"title"`
-	Content string  `json:"content,omitempty"`
-	DueOn   *string `json:"due_on,omitempty"`
-	Notify  bool    `json:"notify,omitempty"`
+	Title       string  `json:"title"`
+	Content     string  `json:"content,omitempty"`
+	DueOn       *string `json:"due_on,omitempty"`
+	AssigneeIDs []int64 `json:"assignee_ids,omitempty"`
+	Notify      bool    `json:"notify,omitempty"` // notify the assignees about the new card
 }
 
 // CardUpdateRequest represents the payload for updating a card
@@ -89,12 +96,13 @@ type CardUpdateRequest struct {
 	Title       string  `json:"title,omitempty"`
 	Content     string  `json:"content,omitempty"`
 	DueOn       *string `json:"due_on,omitempty"`
-	AssigneeIDs []int64 `json:"assignee_ids,omitempty"`
+	AssigneeIDs []int64 `json:"assignee_ids"` // always sent; an empty list unassigns everyone
 }
 
 // CardMoveRequest represents the payload for moving a card
 type CardMoveRequest struct {
 	ColumnID int64 `json:"column_id"`
+	Position int   `json:"position,omitempty"` // 1 is the top; 0 leaves it to Basecamp
 }
 
 // ColumnCreateRequest represents the payload for creating a new column
@@ -106,8 +114,8 @@ type ColumnCreateRequest struct {
 
 // ColumnUpdateRequest represents the payload for updating a column
 type ColumnUpdateRequest struct {
-	Title       string `json:"title,omitempty"`
-	Description string `json:"description,omitempty"`
+	Title       string  `json:"title,omitempty"`
+	Description *string `json:"description,omitempty"` // nil leaves it unchanged; "" clears it
 }
 
 // ColumnColorRequest represents the payload for changing a column's color
@@ -133,7 +141,7 @@ type StepCreateRequest struct {
 type StepUpdateRequest struct {
 	Title     string  `json:"title,omitempty"`
 	DueOn     *string `json:"due_on,omitempty"`
-	Assignees string  `json:"assignees,omitempty"`
+	Assignees *string `json:"assignees,omitempty"` // nil leaves them unchanged; "" unassigns everyone
 }
 
 // StepCompletionRequest represents the payload for changing step completion
@@ -191,7 +199,7 @@ func (c *Client) GetAllProjectCardTables(ctx context.Context, projectID string)
 	}
 
 	if len(cardTables) == 0 {
-		return nil, fmt.Errorf("no card tables found for project")
+		return nil, ErrNoCardTables
 	}
 
 	return cardTables, nil
@@ -204,7 +212,7 @@ func (c *Client) GetProjectCardTable(ctx context.Context, projectID string) (*Ca
 		return nil, err
 	}
 	if len(cardTables) == 0 {
-		return nil, fmt.Errorf("no card tables found for project")
+		return nil, ErrNoCardTables
 	}
 	return cardTables[0], nil
 }
@@ -314,6 +322,19 @@ func (c *Client) MoveCard(ctx context.Context, projectID string, cardID int64, c
 	return nil
 }
 
+// MoveCardToPosition moves a card to a position within a column, 1 being
+// the top. The column may be the card's current one.
+func (c *Client) MoveCardToPosition(ctx context.Context, projectID string, cardID int64, columnID int64, position int) error {
+	path := fmt.Sprintf("/buckets/%s/card_tables/cards/%d/moves.json", projectID, cardID)
+	req := CardMoveRequest{ColumnID: columnID, Position: position}
+
+	if err := c.Post(path, req, nil); err != nil {
+		return fmt.Errorf("failed to move card: %w", err)
+	}
+
+	return nil
+}
+
 // ArchiveCard archives a card
 func (c *Client) ArchiveCard(ctx context.Context, projectID string, cardID int64) error {
 	// Cards are archived by moving them to the archive state
@@ -338,6 +359,18 @@ func (c *Client) CreateColumn(ctx context.Context, projectID string, cardTableID
 	return &column, nil
 }
 
+// GetColumn returns a specific column
+func (c *Client) GetColumn(ctx context.Context, projectID string, columnID int64) (*Column, error) {
+	var column Column
+
+	path := fmt.Sprintf("/buckets/%s/card_tables/columns/%d.json", projectID, columnID)
+	if err := c.Get(path, &column); err != nil {
+		return nil, fmt.Errorf("failed to fetch column: %w", err)
+	}
+
+	return &column, nil
+}
+
 // UpdateColumn updates a column
 func (c *Client) UpdateColumn(ctx context.Context, projectID string, columnID int64, req ColumnUpdateRequest) (*Column, error) {
 	var column Column
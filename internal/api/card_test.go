@@ -0,0 +1,49 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCardSendsAssigneesAndNotify(t *testing.T) {
+	var raw map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/123456/buckets/789/card_tables/lists/42/cards.json", r.URL.Path)
+		raw = nil
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 7, "title": "Release checklist"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	card, err := client.CreateCard(context.Background(), "789", 42, CardCreateRequest{
+		Title:       "Release checklist",
+		AssigneeIDs: []int64{1, 2},
+		Notify:      true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, raw["assignee_ids"])
+	assert.Equal(t, true, raw["notify"])
+	assert.Equal(t, int64(7), card.ID)
+
+	// Without assignees or notify, neither is sent
+	_, err = client.CreateCard(context.Background(), "789", 42, CardCreateRequest{Title: "Plain"})
+	require.NoError(t, err)
+	assert.NotContains(t, raw, "assignee_ids")
+	assert.NotContains(t, raw, "notify")
+}
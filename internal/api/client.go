@@ -190,6 +190,9 @@ type Project struct {
 	Purpose     string `json:"purpose"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
+	AppURL      string `json:"app_url,omitempty"`
+	StartDate   string `json:"start_date,omitempty"` // Only set when the project has dates on the Lineup
+	EndDate     string `json:"end_date,omitempty"`
 }
 
 // GetProjects fetches all projects for the account (handles pagination)
@@ -319,17 +322,18 @@ type TodoSet struct {
 
 // TodoList represents a Basecamp todo list
 type TodoList struct {
-	ID             int64  `json:"id"`
-	Title          string `json:"title"`
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
-	Completed      bool   `json:"completed"`
-	CompletedRatio string `json:"completed_ratio"`
-	TodosCount     int    `json:"todos_count"`
-	TodosURL       string `json:"todos_url"`
-	GroupsURL      string `json:"groups_url"`
+	ID             int64   `json:"id"`
+	Title          string  `json:"title"`
+	Name           string  `json:"name"`
+	Description    string  `json:"description"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+	Completed      bool    `json:"completed"`
+	CompletedRatio string  `json:"completed_ratio"`
+	TodosCount     int     `json:"todos_count"`
+	TodosURL       string  `json:"todos_url"`
+	GroupsURL      string  `json:"groups_url"`
+	Parent         *Parent `json:"parent,omitempty"`
 }
 
 // TodoGroup represents a group of todos within a todo list
@@ -345,6 +349,7 @@ type TodoGroup struct {
 	TodosCount     int    `json:"todos_count"`
 	TodosURL       string `json:"todos_url"`
 	Position       int    `json:"position"`
+	Color          string `json:"color,omitempty"`
 }
 
 // Company represents a Basecamp company/organization
@@ -379,8 +384,11 @@ type Todo struct {
 	DueOn       *string  `json:"due_on"`
 	StartsOn    *string  `json:"starts_on"`
 	TodolistID  int64    `json:"todolist_id"`
+	Parent      *Parent  `json:"parent,omitempty"`
 	Creator     *Person  `json:"creator"`
 	Assignees   []Person `json:"assignees"`
+	BoostsCount int      `json:"boosts_count"`
+	Boosts      []Boost  `json:"boosts,omitempty"` // Populated separately from the boosts endpoint
 }
 
 // GetProjectTodoSet fetches the todo set for a project
@@ -674,6 +682,28 @@ func (c *Client) RepositionTodo(ctx context.Context, projectID string, todoID in
 	return nil
 }
 
+// TodoMoveRequest represents the payload for moving a todo to another list or group
+type TodoMoveRequest struct {
+	Position int   `json:"position"`
+	ParentID int64 `json:"parent_id"`
+}
+
+// MoveTodo moves a todo to a different todo list or group in the same project.
+// parentID is the ID of the destination list or group.
+func (c *Client) MoveTodo(ctx context.Context, projectID string, todoID int64, parentID int64, position int) error {
+	req := TodoMoveRequest{
+		Position: position,
+		ParentID: parentID,
+	}
+
+	path := fmt.Sprintf("/buckets/%s/todos/%d/position.json", projectID, todoID)
+	if err := c.Put(path, req, nil); err != nil {
+		return fmt.Errorf("failed to move todo: %w", err)
+	}
+
+	return nil
+}
+
 // GetTodo fetches a single todo by ID
 func (c *Client) GetTodo(ctx context.Context, projectID string, todoID int64) (*Todo, error) {
 	var todo Todo
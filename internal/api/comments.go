@@ -20,7 +20,9 @@ type Comment struct {
 		Type  string `json:"type"`
 		URL   string `json:"url"`
 	} `json:"parent"`
-	URL string `json:"url"`
+	URL         string  `json:"url"`
+	BoostsCount int     `json:"boosts_count"`
+	Boosts      []Boost `json:"boosts,omitempty"` // Populated separately from the boosts endpoint
 }
 
 // CommentCreateRequest represents the payload for creating a comment
@@ -23,6 +23,7 @@ type APIClient interface {
 	CreateTodoGroup(ctx context.Context, projectID string, todoListID int64, req TodoGroupCreateRequest) (*TodoGroup, error)
 	RepositionTodoGroup(ctx context.Context, projectID string, groupID int64, position int) error
 	RepositionTodo(ctx context.Context, projectID string, todoID int64, position int) error
+	MoveTodo(ctx context.Context, projectID string, todoID int64, parentID int64, position int) error
 	CompleteTodo(ctx context.Context, projectID string, todoID int64) error
 	UncompleteTodo(ctx context.Context, projectID string, todoID int64) error
 
@@ -43,6 +44,7 @@ type APIClient interface {
 	CreateCard(ctx context.Context, projectID string, columnID int64, req CardCreateRequest) (*Card, error)
 	UpdateCard(ctx context.Context, projectID string, cardID int64, req CardUpdateRequest) (*Card, error)
 	MoveCard(ctx context.Context, projectID string, cardID int64, columnID int64) error
+	MoveCardToPosition(ctx context.Context, projectID string, cardID int64, columnID int64, position int) error
 	ArchiveCard(ctx context.Context, projectID string, cardID int64) error
 
 	// Card step methods
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// LineupMarker represents an account-wide marker on the Lineup (e.g. a launch date)
+type LineupMarker struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Date      string    `json:"date"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// LineupMarkerRequest represents the payload for creating or updating a marker
+type LineupMarkerRequest struct {
+	Name string `json:"name,omitempty"`
+	Date string `json:"date,omitempty"`
+}
+
+// ListLineupMarkers returns all Lineup markers in the account
+func (c *Client) ListLineupMarkers(ctx context.Context) ([]LineupMarker, error) {
+	var markers []LineupMarker
+
+	pr := NewPaginatedRequest(c)
+	if err := pr.GetAll("/lineup/markers.json", &markers); err != nil {
+		return nil, fmt.Errorf("failed to list lineup markers: %w", err)
+	}
+
+	return markers, nil
+}
+
+// CreateLineupMarker adds a marker to the Lineup
+func (c *Client) CreateLineupMarker(ctx context.Context, req LineupMarkerRequest) error {
+	if err := c.Post("/lineup/markers.json", req, nil); err != nil {
+		return fmt.Errorf("failed to create lineup marker: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateLineupMarker changes a marker's name or date
+func (c *Client) UpdateLineupMarker(ctx context.Context, markerID int64, req LineupMarkerRequest) error {
+	path := fmt.Sprintf("/lineup/markers/%d.json", markerID)
+
+	if err := c.Put(path, req, nil); err != nil {
+		return fmt.Errorf("failed to update lineup marker: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteLineupMarker removes a marker from the Lineup
+func (c *Client) DeleteLineupMarker(ctx context.Context, markerID int64) error {
+	path := fmt.Sprintf("/lineup/markers/%d.json", markerID)
+
+	if err := c.Delete(path); err != nil {
+		return fmt.Errorf("failed to delete lineup marker: %w", err)
+	}
+
+	return nil
+}
@@ -44,6 +44,8 @@ type Message struct {
 	Category      *MessageCategory `json:"category"`
 	CommentsCount int              `json:"comments_count"`
 	URL           string           `json:"url"`
+	BoostsCount   int              `json:"boosts_count"`
+	Boosts        []Boost          `json:"boosts,omitempty"` // Populated separately from the boosts endpoint
 }
 
 // MessageCategory represents a message category
@@ -76,6 +78,8 @@ type MessageCreateRequest struct {
 	Content    string `json:"content"`
 	Status     string `json:"status,omitempty"` // must be "active" - Basecamp API does not support draft messages
 	CategoryID *int64 `json:"category_id,omitempty"`
+	// Subscriptions limits who is notified; the API notifies everyone on the project when empty
+	Subscriptions []int64 `json:"subscriptions,omitempty"`
 }
 
 // MessageUpdateRequest represents the payload for updating a message
@@ -39,6 +39,7 @@ type MockClient struct {
 	UpdateTodoListError error
 	CompleteTodoError   error
 	UncompleteTodoError error
+	MoveTodoError       error
 
 	// Campfires
 	Campfires               []api.Campfire
@@ -55,6 +56,7 @@ type MockClient struct {
 	CardTable        *api.CardTable
 	CardTableError   error
 	Cards            []api.Card
+	OnHoldCards      []api.Card
 	CardsError       error
 	Card             *api.Card
 	CardError        error
@@ -90,6 +92,10 @@ type MockClient struct {
 	Recording       *api.Recording
 	RecordingError  error
 
+	// Comments
+	Comments      []api.Comment
+	CommentsError error
+
 	// Search
 	SearchResults []api.SearchResult
 	SearchError   error
@@ -243,6 +249,12 @@ func (m *MockClient) RepositionTodo(ctx context.Context, projectID string, todoI
 	return nil
 }
 
+// MoveTodo mock implementation
+func (m *MockClient) MoveTodo(ctx context.Context, projectID string, todoID int64, parentID int64, position int) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("MoveTodo(%s, %d, %d, %d)", projectID, todoID, parentID, position))
+	return m.MoveTodoError
+}
+
 // CompleteTodo mock implementation
 func (m *MockClient) CompleteTodo(ctx context.Context, projectID string, todoID int64) error {
 	m.Calls = append(m.Calls, fmt.Sprintf("CompleteTodo(%s, %d)", projectID, todoID))
@@ -345,6 +357,15 @@ func (m *MockClient) GetCardsInColumn(ctx context.Context, projectID string, col
 	return m.Cards, nil
 }
 
+// GetOnHoldCardsInColumn mock implementation
+func (m *MockClient) GetOnHoldCardsInColumn(ctx context.Context, onHoldCardsURL string) ([]api.Card, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("GetOnHoldCardsInColumn(%s)", onHoldCardsURL))
+	if m.CardsError != nil {
+		return nil, m.CardsError
+	}
+	return m.OnHoldCards, nil
+}
+
 // GetCard mock implementation
 func (m *MockClient) GetCard(ctx context.Context, projectID string, cardID int64) (*api.Card, error) {
 	m.Calls = append(m.Calls, fmt.Sprintf("GetCard(%s, %d)", projectID, cardID))
@@ -378,6 +399,12 @@ func (m *MockClient) MoveCard(ctx context.Context, projectID string, cardID int6
 	return m.MoveCardError
 }
 
+// MoveCardToPosition mock implementation
+func (m *MockClient) MoveCardToPosition(ctx context.Context, projectID string, cardID int64, columnID int64, position int) error {
+	m.Calls = append(m.Calls, fmt.Sprintf("MoveCardToPosition(%s, %d, %d, %d)", projectID, cardID, columnID, position))
+	return m.MoveCardError
+}
+
 // ArchiveCard mock implementation
 func (m *MockClient) ArchiveCard(ctx context.Context, projectID string, cardID int64) error {
 	m.Calls = append(m.Calls, fmt.Sprintf("ArchiveCard(%s, %d)", projectID, cardID))
@@ -573,5 +600,14 @@ func (m *MockClient) Search(ctx context.Context, opts api.SearchOptions) ([]api.
 	return m.SearchResults, nil
 }
 
+// ListComments mock implementation
+func (m *MockClient) ListComments(ctx context.Context, projectID string, recordingID int64) ([]api.Comment, error) {
+	m.Calls = append(m.Calls, fmt.Sprintf("ListComments(%s, %d)", projectID, recordingID))
+	if m.CommentsError != nil {
+		return nil, m.CommentsError
+	}
+	return m.Comments, nil
+}
+
 // Ensure MockClient implements APIClient interface
 var _ api.APIClient = (*MockClient)(nil)
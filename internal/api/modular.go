@@ -32,6 +32,7 @@ type TodoOperations interface {
 	CreateTodoGroup(ctx context.Context, projectID string, todoListID int64, req TodoGroupCreateRequest) (*TodoGroup, error)
 	RepositionTodoGroup(ctx context.Context, projectID string, groupID int64, position int) error
 	RepositionTodo(ctx context.Context, projectID string, todoID int64, position int) error
+	MoveTodo(ctx context.Context, projectID string, todoID int64, parentID int64, position int) error
 	CompleteTodo(ctx context.Context, projectID string, todoID int64) error
 	UncompleteTodo(ctx context.Context, projectID string, todoID int64) error
 }
@@ -57,6 +58,7 @@ type CardOperations interface {
 	CreateCard(ctx context.Context, projectID string, columnID int64, req CardCreateRequest) (*Card, error)
 	UpdateCard(ctx context.Context, projectID string, cardID int64, req CardUpdateRequest) (*Card, error)
 	MoveCard(ctx context.Context, projectID string, cardID int64, columnID int64) error
+	MoveCardToPosition(ctx context.Context, projectID string, cardID int64, columnID int64, position int) error
 	ArchiveCard(ctx context.Context, projectID string, cardID int64) error
 }
 
@@ -71,6 +73,7 @@ type StepOperations interface {
 
 // ColumnOperations defines column-specific operations
 type ColumnOperations interface {
+	GetColumn(ctx context.Context, projectID string, columnID int64) (*Column, error)
 	CreateColumn(ctx context.Context, projectID string, cardTableID int64, req ColumnCreateRequest) (*Column, error)
 	UpdateColumn(ctx context.Context, projectID string, columnID int64, req ColumnUpdateRequest) (*Column, error)
 	SetColumnColor(ctx context.Context, projectID string, columnID int64, color string) error
@@ -113,6 +116,7 @@ type ActivityOperations interface {
 	ListEvents(ctx context.Context, projectID string, recordingID int64) ([]Event, error)
 	ListRecordings(ctx context.Context, projectID string, opts *ActivityListOptions) ([]Recording, error)
 	GetRecording(ctx context.Context, projectID string, recordingID int64) (*Recording, error)
+	TrashRecording(ctx context.Context, projectID string, recordingID int64) error
 }
 
 // ScheduleOperations defines schedule-specific operations
@@ -230,6 +234,86 @@ func (c *ModularClient) Questions() QuestionOperations {
 	return c.Client
 }
 
+// WebhookOperations defines project webhook operations
+type WebhookOperations interface {
+	ListWebhooks(ctx context.Context, projectID string) ([]Webhook, error)
+	GetWebhook(ctx context.Context, projectID string, webhookID int64) (*Webhook, error)
+	CreateWebhook(ctx context.Context, projectID string, req WebhookCreateRequest) (*Webhook, error)
+	UpdateWebhook(ctx context.Context, projectID string, webhookID int64, req WebhookUpdateRequest) (*Webhook, error)
+	DeleteWebhook(ctx context.Context, projectID string, webhookID int64) error
+}
+
+// Webhooks returns the webhook operations interface
+func (c *ModularClient) Webhooks() WebhookOperations {
+	return c.Client
+}
+
+// SubscriptionOperations defines recording subscription operations
+type SubscriptionOperations interface {
+	GetSubscription(ctx context.Context, projectID string, recordingID int64) (*Subscription, error)
+	Subscribe(ctx context.Context, projectID string, recordingID int64) (*Subscription, error)
+	Unsubscribe(ctx context.Context, projectID string, recordingID int64) error
+	UpdateSubscription(ctx context.Context, projectID string, recordingID int64, req SubscriptionUpdateRequest) (*Subscription, error)
+}
+
+// Subscriptions returns the subscription operations interface
+func (c *ModularClient) Subscriptions() SubscriptionOperations {
+	return c.Client
+}
+
+// BoostOperations defines boost (reaction) operations
+type BoostOperations interface {
+	ListBoosts(ctx context.Context, projectID string, recordingID int64) ([]Boost, error)
+	GetBoost(ctx context.Context, projectID string, boostID int64) (*Boost, error)
+	CreateBoost(ctx context.Context, projectID string, recordingID int64, req BoostCreateRequest) (*Boost, error)
+	DeleteBoost(ctx context.Context, projectID string, boostID int64) error
+}
+
+// Boosts returns the boost operations interface
+func (c *ModularClient) Boosts() BoostOperations {
+	return c.Client
+}
+
+// TemplateOperations defines project template operations
+type TemplateOperations interface {
+	ListTemplates(ctx context.Context) ([]Template, error)
+	GetTemplate(ctx context.Context, templateID int64) (*Template, error)
+	CreateTemplate(ctx context.Context, req TemplateRequest) (*Template, error)
+	UpdateTemplate(ctx context.Context, templateID int64, req TemplateRequest) (*Template, error)
+	DeleteTemplate(ctx context.Context, templateID int64) error
+	CreateProjectConstruction(ctx context.Context, templateID int64, req ProjectCreateRequest) (*ProjectConstruction, error)
+	GetProjectConstruction(ctx context.Context, templateID, constructionID int64) (*ProjectConstruction, error)
+}
+
+// Templates returns the template operations interface
+func (c *ModularClient) Templates() TemplateOperations {
+	return c.Client
+}
+
+// LineupOperations defines account-wide Lineup marker operations
+type LineupOperations interface {
+	ListLineupMarkers(ctx context.Context) ([]LineupMarker, error)
+	CreateLineupMarker(ctx context.Context, req LineupMarkerRequest) error
+	UpdateLineupMarker(ctx context.Context, markerID int64, req LineupMarkerRequest) error
+	DeleteLineupMarker(ctx context.Context, markerID int64) error
+}
+
+// Lineup returns the Lineup operations interface
+func (c *ModularClient) Lineup() LineupOperations {
+	return c.Client
+}
+
+// AssignmentOperations defines cross-project assignment report operations
+type AssignmentOperations interface {
+	GetMyAssignments(ctx context.Context) (*MyAssignments, error)
+	GetPersonAssignments(ctx context.Context, personID int64) ([]Assignment, error)
+}
+
+// Assignments returns the assignment operations interface
+func (c *ModularClient) Assignments() AssignmentOperations {
+	return c.Client
+}
+
 // Example of how to extend with new operations without modifying existing code:
 //
 // type MessageOperations interface {
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// Subscription represents the subscription state of a recording
+type Subscription struct {
+	Subscribed  bool     `json:"subscribed"`
+	Count       int      `json:"count"`
+	URL         string   `json:"url"`
+	Subscribers []Person `json:"subscribers"`
+}
+
+// SubscriptionUpdateRequest represents the payload for changing who is
+// subscribed to a recording
+type SubscriptionUpdateRequest struct {
+	Subscriptions   []int64 `json:"subscriptions,omitempty"`
+	Unsubscriptions []int64 `json:"unsubscriptions,omitempty"`
+}
+
+// GetSubscription returns the subscription state of a recording
+func (c *Client) GetSubscription(ctx context.Context, projectID string, recordingID int64) (*Subscription, error) {
+	var subscription Subscription
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/subscription.json", projectID, recordingID)
+
+	if err := c.Get(path, &subscription); err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// Subscribe subscribes the current user to a recording
+func (c *Client) Subscribe(ctx context.Context, projectID string, recordingID int64) (*Subscription, error) {
+	var subscription Subscription
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/subscription.json", projectID, recordingID)
+
+	if err := c.Post(path, nil, &subscription); err != nil {
+		return nil, fmt.Errorf("failed to subscribe: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// Unsubscribe removes the current user from a recording's subscribers
+func (c *Client) Unsubscribe(ctx context.Context, projectID string, recordingID int64) error {
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/subscription.json", projectID, recordingID)
+
+	if err := c.Delete(path); err != nil {
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSubscription adds and removes subscribers on a recording
+func (c *Client) UpdateSubscription(ctx context.Context, projectID string, recordingID int64, req SubscriptionUpdateRequest) (*Subscription, error) {
+	var subscription Subscription
+	path := fmt.Sprintf("/buckets/%s/recordings/%d/subscription.json", projectID, recordingID)
+
+	if err := c.Put(path, req, &subscription); err != nil {
+		return nil, fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	return &subscription, nil
+}
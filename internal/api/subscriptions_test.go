@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubscription(t *testing.T) {
+	var raw map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/123456/buckets/789/recordings/42/subscription.json", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+		_, _ = w.Write([]byte(`{
+			"subscribed": false,
+			"count": 2,
+			"subscribers": [{"id": 1, "name": "Jane"}, {"id": 2, "name": "Bob"}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	subscription, err := client.UpdateSubscription(context.Background(), "789", 42, SubscriptionUpdateRequest{
+		Subscriptions: []int64{1, 2},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []interface{}{float64(1), float64(2)}, raw["subscriptions"])
+	assert.NotContains(t, raw, "unsubscriptions")
+	assert.Equal(t, 2, subscription.Count)
+	assert.Len(t, subscription.Subscribers, 2)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/123456/buckets/789/recordings/42/subscription.json", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	require.NoError(t, client.Unsubscribe(context.Background(), "789", 42))
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Template represents a Basecamp project template
+type Template struct {
+	ID          int64     `json:"id"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	URL         string    `json:"url"`
+	AppURL      string    `json:"app_url"`
+	Dock        []struct {
+		ID      int64  `json:"id"`
+		Title   string `json:"title"`
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+	} `json:"dock,omitempty"`
+}
+
+// TemplateRequest represents the payload for creating or updating a template
+type TemplateRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// ProjectConstruction represents the asynchronous creation of a project from a template
+type ProjectConstruction struct {
+	ID      int64    `json:"id"`
+	Status  string   `json:"status"`
+	URL     string   `json:"url"`
+	Project *Project `json:"project,omitempty"`
+}
+
+// Project construction statuses reported by the API
+const (
+	ConstructionStatusPending   = "pending"
+	ConstructionStatusCompleted = "completed"
+	ConstructionStatusFailed    = "failed"
+)
+
+// ListTemplates returns all active templates in the account
+func (c *Client) ListTemplates(ctx context.Context) ([]Template, error) {
+	var templates []Template
+
+	pr := NewPaginatedRequest(c)
+	if err := pr.GetAll("/templates.json", &templates); err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// GetTemplate returns a specific template
+func (c *Client) GetTemplate(ctx context.Context, templateID int64) (*Template, error) {
+	var template Template
+	path := fmt.Sprintf("/templates/%d.json", templateID)
+
+	if err := c.Get(path, &template); err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	return &template, nil
+}
+
+// CreateTemplate creates a new, empty template
+func (c *Client) CreateTemplate(ctx context.Context, req TemplateRequest) (*Template, error) {
+	var template Template
+
+	if err := c.Post("/templates.json", req, &template); err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+
+	return &template, nil
+}
+
+// UpdateTemplate changes a template's name or description
+func (c *Client) UpdateTemplate(ctx context.Context, templateID int64, req TemplateRequest) (*Template, error) {
+	var template Template
+	path := fmt.Sprintf("/templates/%d.json", templateID)
+
+	if err := c.Put(path, req, &template); err != nil {
+		return nil, fmt.Errorf("failed to update template: %w", err)
+	}
+
+	return &template, nil
+}
+
+// DeleteTemplate trashes a template
+func (c *Client) DeleteTemplate(ctx context.Context, templateID int64) error {
+	path := fmt.Sprintf("/templates/%d.json", templateID)
+
+	if err := c.Delete(path); err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+
+	return nil
+}
+
+// CreateProjectConstruction starts building a new project from a template.
+// Construction happens asynchronously; poll GetProjectConstruction until the
+// status is completed.
+func (c *Client) CreateProjectConstruction(ctx context.Context, templateID int64, req ProjectCreateRequest) (*ProjectConstruction, error) {
+	var construction ProjectConstruction
+	path := fmt.Sprintf("/templates/%d/project_constructions.json", templateID)
+
+	payload := struct {
+		Project ProjectCreateRequest `json:"project"`
+	}{Project: req}
+
+	if err := c.Post(path, payload, &construction); err != nil {
+		return nil, fmt.Errorf("failed to create project from template: %w", err)
+	}
+
+	return &construction, nil
+}
+
+// GetProjectConstruction returns the current status of a project construction
+func (c *Client) GetProjectConstruction(ctx context.Context, templateID, constructionID int64) (*ProjectConstruction, error) {
+	var construction ProjectConstruction
+	path := fmt.Sprintf("/templates/%d/project_constructions/%d.json", templateID, constructionID)
+
+	if err := c.Get(path, &construction); err != nil {
+		return nil, fmt.Errorf("failed to get project construction: %w", err)
+	}
+
+	return &construction, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateProjectConstruction(t *testing.T) {
+	var raw map[string]map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/123456/templates/55/project_constructions.json", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 7, "status": "pending", "url": "https://example.com/7.json"}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	construction, err := client.CreateProjectConstruction(context.Background(), 55, ProjectCreateRequest{
+		Name: "Acme launch",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Acme launch", raw["project"]["name"])
+	assert.Equal(t, int64(7), construction.ID)
+	assert.Equal(t, ConstructionStatusPending, construction.Status)
+	assert.Nil(t, construction.Project)
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Webhook represents a Basecamp project webhook
+type Webhook struct {
+	ID               int64             `json:"id"`
+	Active           bool              `json:"active"`
+	CreatedAt        time.Time         `json:"created_at"`
+	UpdatedAt        time.Time         `json:"updated_at"`
+	PayloadURL       string            `json:"payload_url"`
+	Types            []string          `json:"types"`
+	URL              string            `json:"url"`
+	AppURL           string            `json:"app_url"`
+	RecentDeliveries []WebhookDelivery `json:"recent_deliveries,omitempty"`
+}
+
+// WebhookDelivery represents a recent delivery attempt of a webhook
+type WebhookDelivery struct {
+	ID        int64     `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Request   struct {
+		Headers map[string]string `json:"headers"`
+		Body    json.RawMessage   `json:"body"`
+	} `json:"request"`
+	Response struct {
+		Code    int               `json:"code"`
+		Headers map[string]string `json:"headers"`
+		Message string            `json:"message"`
+	} `json:"response"`
+}
+
+// WebhookCreateRequest represents the payload for creating a webhook
+type WebhookCreateRequest struct {
+	PayloadURL string   `json:"payload_url"`
+	Types      []string `json:"types,omitempty"`
+}
+
+// WebhookUpdateRequest represents the payload for updating a webhook
+type WebhookUpdateRequest struct {
+	PayloadURL string   `json:"payload_url,omitempty"`
+	Types      []string `json:"types,omitempty"`
+	Active     *bool    `json:"active,omitempty"`
+}
+
+// WebhookEvent represents the payload Basecamp delivers to a webhook's payload URL
+type WebhookEvent struct {
+	ID        int64                  `json:"id"`
+	Kind      string                 `json:"kind"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	Recording Recording              `json:"recording"`
+	Creator   Person                 `json:"creator"`
+}
+
+// ValidWebhookTypes maps friendly type names to the recording types accepted
+// by the webhooks API
+var ValidWebhookTypes = map[string]string{
+	"comment":   "Comment",
+	"document":  "Document",
+	"message":   "Message",
+	"question":  "Question",
+	"answer":    "Question::Answer",
+	"event":     "Schedule::Entry",
+	"todo":      "Todo",
+	"todolist":  "Todolist",
+	"upload":    "Upload",
+	"vault":     "Vault",
+	"card":      "Kanban::Card",
+	"step":      "Kanban::Step",
+	"cloudfile": "CloudFile",
+}
+
+// NormalizeWebhookType converts a friendly or API type name to the API form.
+// Unknown names are returned unchanged so new Basecamp types keep working.
+func NormalizeWebhookType(t string) string {
+	t = strings.TrimSpace(t)
+	if mapped, ok := ValidWebhookTypes[strings.ToLower(t)]; ok {
+		return mapped
+	}
+	return t
+}
+
+// ListWebhooks returns all webhooks configured for a project
+func (c *Client) ListWebhooks(ctx context.Context, projectID string) ([]Webhook, error) {
+	var webhooks []Webhook
+	path := fmt.Sprintf("/buckets/%s/webhooks.json", projectID)
+
+	pr := NewPaginatedRequest(c)
+	if err := pr.GetAll(path, &webhooks); err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// GetWebhook returns a specific webhook, including its recent deliveries
+func (c *Client) GetWebhook(ctx context.Context, projectID string, webhookID int64) (*Webhook, error) {
+	var webhook Webhook
+	path := fmt.Sprintf("/buckets/%s/webhooks/%d.json", projectID, webhookID)
+
+	if err := c.Get(path, &webhook); err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// CreateWebhook creates a new webhook for a project
+func (c *Client) CreateWebhook(ctx context.Context, projectID string, req WebhookCreateRequest) (*Webhook, error) {
+	var webhook Webhook
+	path := fmt.Sprintf("/buckets/%s/webhooks.json", projectID)
+
+	if err := c.Post(path, req, &webhook); err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// UpdateWebhook updates an existing webhook
+func (c *Client) UpdateWebhook(ctx context.Context, projectID string, webhookID int64, req WebhookUpdateRequest) (*Webhook, error) {
+	var webhook Webhook
+	path := fmt.Sprintf("/buckets/%s/webhooks/%d.json", projectID, webhookID)
+
+	if err := c.Put(path, req, &webhook); err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	return &webhook, nil
+}
+
+// DeleteWebhook permanently deletes a webhook
+func (c *Client) DeleteWebhook(ctx context.Context, projectID string, webhookID int64) error {
+	path := fmt.Sprintf("/buckets/%s/webhooks/%d.json", projectID, webhookID)
+
+	if err := c.Delete(path); err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	return nil
+}
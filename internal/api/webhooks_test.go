@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	var received WebhookCreateRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/123456/buckets/789/webhooks.json", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&received)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"id": 42,
+			"active": true,
+			"payload_url": "https://example.com/hook",
+			"types": ["Todo", "Comment"]
+		}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	webhook, err := client.CreateWebhook(context.Background(), "789", WebhookCreateRequest{
+		PayloadURL: "https://example.com/hook",
+		Types:      []string{"Todo", "Comment"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.com/hook", received.PayloadURL)
+	assert.Equal(t, []string{"Todo", "Comment"}, received.Types)
+	assert.Equal(t, int64(42), webhook.ID)
+	assert.True(t, webhook.Active)
+}
+
+func TestUpdateWebhookOmitsUnsetFields(t *testing.T) {
+	var raw map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/123456/buckets/789/webhooks/42.json", r.URL.Path)
+		_ = json.NewDecoder(r.Body).Decode(&raw)
+		_, _ = w.Write([]byte(`{"id": 42, "active": false}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		accountID:  "123456",
+		baseURL:    server.URL,
+		httpClient: &http.Client{},
+	}
+
+	active := false
+	_, err := client.UpdateWebhook(context.Background(), "789", 42, WebhookUpdateRequest{Active: &active})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"active": false}, raw)
+}
+
+func TestNormalizeWebhookType(t *testing.T) {
+	assert.Equal(t, "Kanban::Card", NormalizeWebhookType("card"))
+	assert.Equal(t, "Todo", NormalizeWebhookType("TODO"))
+	assert.Equal(t, "Client::Forward", NormalizeWebhookType("Client::Forward"))
+}
@@ -52,6 +52,20 @@ func ParseAttachments(htmlContent string) []Attachment {
 	return attachments
 }
 
+// ReplaceAttachments rewrites every bc-attachment element in HTML content with
+// the string returned by replace. Returning the original tag leaves it unchanged.
+func ReplaceAttachments(htmlContent string, replace func(tag string, att Attachment) string) string {
+	re := regexp.MustCompile(`(?s)<bc-attachment([^>]*)(?:>.*?</bc-attachment>|/>)`)
+
+	return re.ReplaceAllStringFunc(htmlContent, func(tag string) string {
+		atts := ParseAttachments(tag)
+		if len(atts) == 0 {
+			return tag
+		}
+		return replace(tag, atts[0])
+	})
+}
+
 // extractAttribute extracts the value of an HTML attribute from a string
 func extractAttribute(attrs, attrName string) string {
 	// Pattern to match attribute="value" or attribute='value'
@@ -115,6 +115,22 @@ func TestBuildTag(t *testing.T) {
 	}
 }
 
+func TestReplaceAttachments(t *testing.T) {
+	html := `<div>Before <bc-attachment sgid="old1" filename="a.png"></bc-attachment> middle <bc-attachment sgid="old2"/> after</div>`
+
+	got := ReplaceAttachments(html, func(tag string, att Attachment) string {
+		if att.SGID == "old2" {
+			return tag
+		}
+		return BuildTag("new-" + att.SGID)
+	})
+
+	expected := `<div>Before <bc-attachment sgid="new-old1"></bc-attachment> middle <bc-attachment sgid="old2"/> after</div>`
+	if got != expected {
+		t.Errorf("ReplaceAttachments() = %q, want %q", got, expected)
+	}
+}
+
 func TestGetDisplayName(t *testing.T) {
 	tests := []struct {
 		name       string
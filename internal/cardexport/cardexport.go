@@ -0,0 +1,189 @@
+// Package cardexport defines the file format card tables are exported to and
+// imported from, and reads Trello board exports into it.
+package cardexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Version is the current version of the export format
+const Version = 1
+
+// Board is an exported card table
+type Board struct {
+	Version     int       `json:"version"`
+	Source      string    `json:"source,omitempty"` // "bc4" or "trello"
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	URL         string    `json:"url,omitempty"`
+	ExportedAt  time.Time `json:"exported_at,omitempty"`
+	Columns     []Column  `json:"columns"`
+}
+
+// Column is a card table column with its cards, on-hold ones included
+type Column struct {
+	Title string `json:"title"`
+	Type  string `json:"type,omitempty"`
+	Color string `json:"color,omitempty"`
+	Cards []Card `json:"cards"`
+}
+
+// Card is a card with its steps and comments. Content and comments are
+// Basecamp rich text (HTML).
+type Card struct {
+	ID        int64     `json:"id,omitempty"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content,omitempty"`
+	DueOn     string    `json:"due_on,omitempty"`
+	OnHold    bool      `json:"on_hold,omitempty"`
+	Assignees []Person  `json:"assignees,omitempty"`
+	Steps     []Step    `json:"steps,omitempty"`
+	Comments  []Comment `json:"comments,omitempty"`
+	Creator   *Person   `json:"creator,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	URL       string    `json:"url,omitempty"`
+}
+
+// Step is a card step
+type Step struct {
+	Title     string   `json:"title"`
+	Completed bool     `json:"completed,omitempty"`
+	DueOn     string   `json:"due_on,omitempty"`
+	Assignees []Person `json:"assignees,omitempty"`
+}
+
+// Comment is a comment on a card
+type Comment struct {
+	Author    Person    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+	Content   string    `json:"content"`
+}
+
+// Person identifies someone by name and, when known, email address
+type Person struct {
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// Identifier returns the best way to look the person up: their email
+// address, or their name
+func (p Person) Identifier() string {
+	if p.Email != "" {
+		return p.Email
+	}
+	return p.Name
+}
+
+// CardCount returns the number of cards on the board
+func (b *Board) CardCount() int {
+	n := 0
+	for _, column := range b.Columns {
+		n += len(column.Cards)
+	}
+	return n
+}
+
+// Decode reads a board exported by bc4 or a Trello board JSON export
+func Decode(r io.Reader) (*Board, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read export: %w", err)
+	}
+
+	var probe struct {
+		Columns json.RawMessage `json:"columns"`
+		Lists   json.RawMessage `json:"lists"`
+		Cards   json.RawMessage `json:"cards"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	switch {
+	case probe.Columns != nil:
+		var board Board
+		if err := json.Unmarshal(data, &board); err != nil {
+			return nil, fmt.Errorf("invalid card table export: %w", err)
+		}
+		if board.Version > Version {
+			return nil, fmt.Errorf("export format version %d is newer than this version of bc4 supports (%d)", board.Version, Version)
+		}
+		return &board, nil
+	case probe.Lists != nil && probe.Cards != nil:
+		return parseTrello(data)
+	default:
+		return nil, fmt.Errorf("not a bc4 card table export or a Trello board export")
+	}
+}
+
+// CSVHeader is the header row written by WriteCSV
+var CSVHeader = []string{
+	"column", "column_color", "card_id", "title", "content", "due_on", "on_hold",
+	"assignees", "steps", "comments", "created_at", "url",
+}
+
+// WriteCSV writes one row per card. Steps are listed one per line as
+// "[x] title" or "[ ] title", and comments one per line as
+// "Name (date): text".
+func WriteCSV(w io.Writer, board *Board, plainText func(string) string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(CSVHeader); err != nil {
+		return err
+	}
+
+	for _, column := range board.Columns {
+		for _, card := range column.Cards {
+			var assignees []string
+			for _, person := range card.Assignees {
+				assignees = append(assignees, person.Identifier())
+			}
+			var steps []string
+			for _, step := range card.Steps {
+				mark := "[ ]"
+				if step.Completed {
+					mark = "[x]"
+				}
+				steps = append(steps, mark+" "+step.Title)
+			}
+			var comments []string
+			for _, comment := range card.Comments {
+				text := strings.Join(strings.Fields(plainText(comment.Content)), " ")
+				comments = append(comments, fmt.Sprintf("%s (%s): %s", comment.Author.Name, comment.CreatedAt.Format("2006-01-02"), text))
+			}
+			id := ""
+			if card.ID != 0 {
+				id = fmt.Sprintf("%d", card.ID)
+			}
+			created := ""
+			if !card.CreatedAt.IsZero() {
+				created = card.CreatedAt.Format(time.RFC3339)
+			}
+
+			record := []string{
+				column.Title,
+				column.Color,
+				id,
+				card.Title,
+				plainText(card.Content),
+				card.DueOn,
+				fmt.Sprintf("%t", card.OnHold),
+				strings.Join(assignees, ", "),
+				strings.Join(steps, "\n"),
+				strings.Join(comments, "\n"),
+				created,
+				card.URL,
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,146 @@
+package cardexport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const trelloExport = `{
+  "name": "Launch",
+  "desc": "",
+  "url": "https://trello.com/b/abc/launch",
+  "lists": [
+    {"id": "l2", "name": "Doing", "pos": 2000},
+    {"id": "l1", "name": "To Do", "pos": 1000},
+    {"id": "l3", "name": "Old", "closed": true, "pos": 3000}
+  ],
+  "cards": [
+    {"id": "c2", "name": "Second", "idList": "l1", "pos": 200, "desc": ""},
+    {"id": "c1", "name": "First", "idList": "l1", "pos": 100, "desc": "Some **bold** text",
+     "due": "2025-04-01T12:00:00.000Z", "idMembers": ["m1", "m9"],
+     "labels": [{"name": "Urgent", "color": "red"}, {"name": "", "color": "blue"}],
+     "shortUrl": "https://trello.com/c/xyz"},
+    {"id": "c3", "name": "Archived", "idList": "l2", "closed": true, "pos": 100},
+    {"id": "c4", "name": "Working", "idList": "l2", "pos": 100}
+  ],
+  "checklists": [
+    {"id": "k2", "idCard": "c1", "name": "QA", "pos": 2, "checkItems": [
+      {"name": "Test", "state": "incomplete", "pos": 1}
+    ]},
+    {"id": "k1", "idCard": "c1", "name": "Build", "pos": 1, "checkItems": [
+      {"name": "Deploy", "state": "incomplete", "pos": 2},
+      {"name": "Compile", "state": "complete", "pos": 1, "idMember": "m1"}
+    ]},
+    {"id": "k3", "idCard": "c4", "name": "Only", "pos": 1, "checkItems": [
+      {"name": "Single", "state": "incomplete", "pos": 1}
+    ]}
+  ],
+  "members": [{"id": "m1", "fullName": "Jane Doe", "username": "jane"}],
+  "actions": [
+    {"type": "commentCard", "date": "2025-03-02T10:00:00.000Z", "memberCreator": {"id": "m1", "fullName": "Jane Doe"},
+     "data": {"text": "Later", "card": {"id": "c1"}}},
+    {"type": "updateCard", "date": "2025-03-01T10:00:00.000Z", "data": {"card": {"id": "c1"}}},
+    {"type": "commentCard", "date": "2025-03-01T10:00:00.000Z", "memberCreator": {"id": "m2", "username": "bob"},
+     "data": {"text": "Earlier", "card": {"id": "c1"}}}
+  ]
+}`
+
+func TestDecode_Trello(t *testing.T) {
+	board, err := Decode(strings.NewReader(trelloExport))
+	require.NoError(t, err)
+
+	assert.Equal(t, "trello", board.Source)
+	assert.Equal(t, "Launch", board.Title)
+	require.Len(t, board.Columns, 2, "closed lists are skipped")
+	assert.Equal(t, "To Do", board.Columns[0].Title)
+	assert.Equal(t, "Doing", board.Columns[1].Title)
+	assert.Equal(t, 3, board.CardCount())
+
+	todo := board.Columns[0]
+	require.Len(t, todo.Cards, 2)
+	first := todo.Cards[0]
+	assert.Equal(t, "First", first.Title)
+	assert.Equal(t, "Second", todo.Cards[1].Title)
+	assert.Equal(t, "2025-04-01", first.DueOn)
+	assert.Equal(t, "https://trello.com/c/xyz", first.URL)
+	assert.Contains(t, first.Content, "<strong>bold</strong>")
+	assert.Contains(t, first.Content, "Labels: Urgent, blue")
+	assert.Equal(t, []Person{{Name: "Jane Doe"}}, first.Assignees, "unknown members are dropped")
+
+	assert.Equal(t, []Step{
+		{Title: "Build: Compile", Completed: true, Assignees: []Person{{Name: "Jane Doe"}}},
+		{Title: "Build: Deploy"},
+		{Title: "QA: Test"},
+	}, first.Steps)
+
+	require.Len(t, first.Comments, 2)
+	assert.Equal(t, "bob", first.Comments[0].Author.Name)
+	assert.Contains(t, first.Comments[0].Content, "Earlier")
+	assert.Contains(t, first.Comments[1].Content, "Later")
+
+	working := board.Columns[1].Cards
+	require.Len(t, working, 1, "archived cards are skipped")
+	assert.Equal(t, []Step{{Title: "Single"}}, working[0].Steps, "a lone checklist keeps plain titles")
+}
+
+func TestDecode_Export(t *testing.T) {
+	board, err := Decode(strings.NewReader(`{"version": 1, "title": "Roadmap", "columns": [{"title": "Triage", "cards": [{"title": "A"}]}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, "Roadmap", board.Title)
+	assert.Equal(t, 1, board.CardCount())
+
+	_, err = Decode(strings.NewReader(`{"version": 99, "columns": []}`))
+	assert.ErrorContains(t, err, "newer")
+
+	_, err = Decode(strings.NewReader(`{"todos": []}`))
+	assert.ErrorContains(t, err, "not a bc4 card table export")
+
+	_, err = Decode(strings.NewReader(`not json`))
+	assert.ErrorContains(t, err, "invalid JSON")
+}
+
+func TestWriteCSV(t *testing.T) {
+	board := &Board{
+		Columns: []Column{{
+			Title: "Doing",
+			Color: "blue",
+			Cards: []Card{{
+				ID:        42,
+				Title:     "Ship it",
+				Content:   "<div>Body</div>",
+				DueOn:     "2025-04-01",
+				OnHold:    true,
+				Assignees: []Person{{Name: "Jane", Email: "jane@example.com"}, {Name: "Bob"}},
+				Steps:     []Step{{Title: "Build", Completed: true}, {Title: "Release"}},
+				Comments: []Comment{{
+					Author:    Person{Name: "Jane"},
+					CreatedAt: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+					Content:   "<div>Looks\n good</div>",
+				}},
+				CreatedAt: time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC),
+			}},
+		}},
+	}
+
+	var buf bytes.Buffer
+	strip := func(s string) string {
+		return strings.NewReplacer("<div>", "", "</div>", "").Replace(s)
+	}
+	require.NoError(t, WriteCSV(&buf, board, strip))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, CSVHeader, records[0])
+	assert.Equal(t, []string{
+		"Doing", "blue", "42", "Ship it", "Body", "2025-04-01", "true",
+		"jane@example.com, Bob", "[x] Build\n[ ] Release", "Jane (2025-03-01): Looks good",
+		"2025-02-01T09:00:00Z", "",
+	}, records[1])
+}
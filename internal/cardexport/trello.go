@@ -0,0 +1,222 @@
+package cardexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+// trelloBoard is the part of a Trello board JSON export bc4 reads
+type trelloBoard struct {
+	Name       string            `json:"name"`
+	Desc       string            `json:"desc"`
+	URL        string            `json:"url"`
+	Lists      []trelloList      `json:"lists"`
+	Cards      []trelloCard      `json:"cards"`
+	Checklists []trelloChecklist `json:"checklists"`
+	Members    []trelloMember    `json:"members"`
+	Actions    []trelloAction    `json:"actions"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Desc         string        `json:"desc"`
+	IDList       string        `json:"idList"`
+	Closed       bool          `json:"closed"`
+	Due          *time.Time    `json:"due"`
+	IDMembers    []string      `json:"idMembers"`
+	IDChecklists []string      `json:"idChecklists"`
+	Pos          float64       `json:"pos"`
+	Labels       []trelloLabel `json:"labels"`
+	ShortURL     string        `json:"shortUrl"`
+}
+
+type trelloLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloChecklist struct {
+	ID         string            `json:"id"`
+	IDCard     string            `json:"idCard"`
+	Name       string            `json:"name"`
+	Pos        float64           `json:"pos"`
+	CheckItems []trelloCheckItem `json:"checkItems"`
+}
+
+type trelloCheckItem struct {
+	Name     string     `json:"name"`
+	State    string     `json:"state"`
+	Pos      float64    `json:"pos"`
+	Due      *time.Time `json:"due"`
+	IDMember string     `json:"idMember"`
+}
+
+type trelloMember struct {
+	ID       string `json:"id"`
+	FullName string `json:"fullName"`
+	Username string `json:"username"`
+}
+
+type trelloAction struct {
+	Type          string       `json:"type"`
+	Date          time.Time    `json:"date"`
+	MemberCreator trelloMember `json:"memberCreator"`
+	Data          struct {
+		Text string `json:"text"`
+		Card struct {
+			ID string `json:"id"`
+		} `json:"card"`
+	} `json:"data"`
+}
+
+// parseTrello converts a Trello board export: open lists become columns,
+// open cards become cards, checklist items become steps and comments are
+// kept with their author. Trello members have no email address in exports,
+// so people are identified by name.
+func parseTrello(data []byte) (*Board, error) {
+	var tb trelloBoard
+	if err := json.Unmarshal(data, &tb); err != nil {
+		return nil, fmt.Errorf("invalid Trello export: %w", err)
+	}
+
+	converter := markdown.NewConverter()
+	richText := func(md string) string {
+		if strings.TrimSpace(md) == "" {
+			return ""
+		}
+		rt, err := converter.MarkdownToRichText(md)
+		if err != nil {
+			return "<div>" + html.EscapeString(md) + "</div>"
+		}
+		return rt
+	}
+
+	members := make(map[string]Person, len(tb.Members))
+	for _, m := range tb.Members {
+		members[m.ID] = trelloPerson(m)
+	}
+
+	checklists := make(map[string][]trelloChecklist)
+	for _, cl := range tb.Checklists {
+		checklists[cl.IDCard] = append(checklists[cl.IDCard], cl)
+	}
+
+	comments := make(map[string][]Comment)
+	for _, action := range tb.Actions {
+		if action.Type != "commentCard" {
+			continue
+		}
+		comments[action.Data.Card.ID] = append(comments[action.Data.Card.ID], Comment{
+			Author:    trelloPerson(action.MemberCreator),
+			CreatedAt: action.Date,
+			Content:   richText(action.Data.Text),
+		})
+	}
+
+	lists := append([]trelloList(nil), tb.Lists...)
+	sort.SliceStable(lists, func(i, j int) bool { return lists[i].Pos < lists[j].Pos })
+	cards := append([]trelloCard(nil), tb.Cards...)
+	sort.SliceStable(cards, func(i, j int) bool { return cards[i].Pos < cards[j].Pos })
+
+	board := &Board{
+		Version:     Version,
+		Source:      "trello",
+		Title:       tb.Name,
+		Description: richText(tb.Desc),
+		URL:         tb.URL,
+	}
+
+	for _, list := range lists {
+		if list.Closed {
+			continue
+		}
+		column := Column{Title: list.Name}
+
+		for _, tc := range cards {
+			if tc.Closed || tc.IDList != list.ID {
+				continue
+			}
+
+			content := tc.Desc
+			if len(tc.Labels) > 0 {
+				var labels []string
+				for _, label := range tc.Labels {
+					name := label.Name
+					if name == "" {
+						name = label.Color
+					}
+					labels = append(labels, name)
+				}
+				content = strings.TrimSpace(content + "\n\nLabels: " + strings.Join(labels, ", "))
+			}
+
+			card := Card{
+				Title:   tc.Name,
+				Content: richText(content),
+				URL:     tc.ShortURL,
+			}
+			if tc.Due != nil {
+				card.DueOn = tc.Due.UTC().Format("2006-01-02")
+			}
+			for _, id := range tc.IDMembers {
+				if person, ok := members[id]; ok {
+					card.Assignees = append(card.Assignees, person)
+				}
+			}
+
+			cardChecklists := checklists[tc.ID]
+			sort.SliceStable(cardChecklists, func(i, j int) bool { return cardChecklists[i].Pos < cardChecklists[j].Pos })
+			for _, cl := range cardChecklists {
+				items := append([]trelloCheckItem(nil), cl.CheckItems...)
+				sort.SliceStable(items, func(i, j int) bool { return items[i].Pos < items[j].Pos })
+				for _, item := range items {
+					title := item.Name
+					// Steps are a single list, so keep the checklist name when there are several
+					if len(cardChecklists) > 1 && cl.Name != "" {
+						title = cl.Name + ": " + title
+					}
+					step := Step{Title: title, Completed: item.State == "complete"}
+					if item.Due != nil {
+						step.DueOn = item.Due.UTC().Format("2006-01-02")
+					}
+					if person, ok := members[item.IDMember]; ok {
+						step.Assignees = []Person{person}
+					}
+					card.Steps = append(card.Steps, step)
+				}
+			}
+
+			cardComments := comments[tc.ID]
+			sort.SliceStable(cardComments, func(i, j int) bool { return cardComments[i].CreatedAt.Before(cardComments[j].CreatedAt) })
+			card.Comments = cardComments
+
+			column.Cards = append(column.Cards, card)
+		}
+
+		board.Columns = append(board.Columns, column)
+	}
+
+	return board, nil
+}
+
+func trelloPerson(m trelloMember) Person {
+	name := m.FullName
+	if name == "" {
+		name = m.Username
+	}
+	return Person{Name: name}
+}
@@ -0,0 +1,217 @@
+// Package cardtemplate reads card templates: Markdown files with YAML front
+// matter, or plain YAML files, describing a card to create.
+//
+// A template looks like this:
+//
+//	---
+//	title: "Bug: {{title}}"
+//	column: Triage
+//	assignees: [jane@example.com]
+//	due: +3d
+//	steps:
+//	  - Reproduce
+//	  - title: Write a failing test
+//	    assignees: ["@bob"]
+//	    due: +1d
+//	---
+//
+//	## Steps to reproduce
+//
+// Templates are looked up in the .bc4/templates/cards directory of the
+// current repository first, then in templates/cards under the bc4 config
+// directory.
+package cardtemplate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// Extensions are the file extensions templates can have, in lookup order
+var Extensions = []string{".md", ".yaml", ".yml"}
+
+// Template describes a card to create
+type Template struct {
+	Name string `yaml:"-" json:"name"`
+	Path string `yaml:"-" json:"path"`
+
+	// Title is a pattern where {{title}} is replaced by the title given on
+	// the command line and {{date}} by today's date
+	Title     string   `yaml:"title" json:"title,omitempty"`
+	Column    string   `yaml:"column" json:"column,omitempty"`
+	Assignees []string `yaml:"assignees" json:"assignees,omitempty"`
+	// Due is a date relative to when the card is created, such as +3d or
+	// "next friday"
+	Due   string `yaml:"due" json:"due,omitempty"`
+	Steps []Step `yaml:"steps" json:"steps,omitempty"`
+	// Body is Markdown content; in Markdown templates it is the text after
+	// the front matter
+	Body string `yaml:"body" json:"body,omitempty"`
+}
+
+// Step is a step of the checklist a template creates
+type Step struct {
+	Title     string   `yaml:"title" json:"title,omitempty"`
+	Assignees []string `yaml:"assignees" json:"assignees,omitempty"`
+	Due       string   `yaml:"due" json:"due,omitempty"`
+}
+
+// UnmarshalYAML accepts a plain string as a step with just a title
+func (s *Step) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		s.Title = node.Value
+		return nil
+	}
+	type plain Step
+	return node.Decode((*plain)(s))
+}
+
+// Parse reads a template. Markdown files (.md) carry their settings in
+// front matter; other files are read as YAML.
+func Parse(name, path string, data []byte) (*Template, error) {
+	t := &Template{Name: name, Path: path}
+
+	content := string(data)
+	if strings.EqualFold(filepath.Ext(path), ".md") {
+		front, body, ok := utils.SplitFrontMatter(content)
+		if ok {
+			if err := yaml.Unmarshal([]byte(front), t); err != nil {
+				return nil, fmt.Errorf("invalid front matter in %s: %w", path, err)
+			}
+		}
+		t.Body = strings.TrimSpace(body)
+	} else if err := yaml.Unmarshal(data, t); err != nil {
+		return nil, fmt.Errorf("invalid template %s: %w", path, err)
+	}
+
+	for i, step := range t.Steps {
+		if strings.TrimSpace(step.Title) == "" {
+			return nil, fmt.Errorf("step %d of template %s has no title", i+1, path)
+		}
+	}
+
+	return t, nil
+}
+
+// RenderTitle fills in the title pattern. A pattern without {{title}} is a
+// fixed title, which a given title replaces.
+func (t *Template) RenderTitle(title string, now time.Time) (string, error) {
+	title = strings.TrimSpace(title)
+	pattern := strings.TrimSpace(t.Title)
+	if pattern == "" {
+		pattern = "{{title}}"
+	}
+	if !strings.Contains(pattern, "{{title}}") && title != "" {
+		return title, nil
+	}
+	if strings.Contains(pattern, "{{title}}") && title == "" {
+		return "", fmt.Errorf("template %q needs a title", t.Name)
+	}
+
+	r := strings.NewReplacer("{{title}}", title, "{{date}}", now.Format("2006-01-02"))
+	return r.Replace(pattern), nil
+}
+
+// Dirs returns the directories templates are read from: the repository's
+// .bc4/templates/cards (found by walking up from workDir) and the user's
+// templates/cards under configDir
+func Dirs(configDir, workDir string) []string {
+	var dirs []string
+	for dir := workDir; dir != ""; {
+		candidate := filepath.Join(dir, ".bc4")
+		if info, err := os.Stat(candidate); err == nil && info.IsDir() {
+			dirs = append(dirs, filepath.Join(candidate, "templates", "cards"))
+			break
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	if configDir != "" {
+		dirs = append(dirs, filepath.Join(configDir, "templates", "cards"))
+	}
+	return dirs
+}
+
+// Find loads a template by name, or from a path to a template file
+func Find(dirs []string, name string) (*Template, error) {
+	if strings.ContainsRune(name, os.PathSeparator) || filepath.Ext(name) != "" {
+		if data, err := os.ReadFile(name); err == nil {
+			return Parse(strings.TrimSuffix(filepath.Base(name), filepath.Ext(name)), name, data)
+		}
+	}
+
+	for _, dir := range dirs {
+		for _, ext := range Extensions {
+			path := filepath.Join(dir, name+ext)
+			data, err := os.ReadFile(path)
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to read template: %w", err)
+			}
+			return Parse(name, path, data)
+		}
+	}
+
+	return nil, fmt.Errorf("card template %q not found (looked in %s)", name, strings.Join(dirs, ", "))
+}
+
+// List loads every template in dirs. Templates earlier in dirs hide ones
+// with the same name further down.
+func List(dirs []string) ([]*Template, error) {
+	seen := make(map[string]bool)
+	var templates []*Template
+
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read templates: %w", err)
+		}
+		for _, entry := range entries {
+			ext := filepath.Ext(entry.Name())
+			name := strings.TrimSuffix(entry.Name(), ext)
+			if entry.IsDir() || !isTemplateExt(ext) || seen[name] {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read template: %w", err)
+			}
+			t, err := Parse(name, path, data)
+			if err != nil {
+				return nil, err
+			}
+			seen[name] = true
+			templates = append(templates, t)
+		}
+	}
+
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+func isTemplateExt(ext string) bool {
+	for _, e := range Extensions {
+		if strings.EqualFold(e, ext) {
+			return true
+		}
+	}
+	return false
+}
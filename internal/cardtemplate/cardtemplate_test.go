@@ -0,0 +1,117 @@
+package cardtemplate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bugTemplate = `---
+title: "Bug: {{title}}"
+column: Triage
+assignees: [jane@example.com]
+due: +3d
+steps:
+  - Reproduce
+  - title: Write a failing test
+    assignees: ["@bob"]
+    due: +1d
+---
+
+## Steps to reproduce
+`
+
+func TestParse_Markdown(t *testing.T) {
+	tmpl, err := Parse("bug", "bug.md", []byte(bugTemplate))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Bug: {{title}}", tmpl.Title)
+	assert.Equal(t, "Triage", tmpl.Column)
+	assert.Equal(t, []string{"jane@example.com"}, tmpl.Assignees)
+	assert.Equal(t, "+3d", tmpl.Due)
+	assert.Equal(t, "## Steps to reproduce", tmpl.Body)
+	assert.Equal(t, []Step{
+		{Title: "Reproduce"},
+		{Title: "Write a failing test", Assignees: []string{"@bob"}, Due: "+1d"},
+	}, tmpl.Steps)
+}
+
+func TestParse_YAML(t *testing.T) {
+	tmpl, err := Parse("chore", "chore.yaml", []byte("title: Chore\nbody: |\n  Do the thing\nsteps: [One, Two]\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "Do the thing\n", tmpl.Body)
+	assert.Len(t, tmpl.Steps, 2)
+
+	_, err = Parse("broken", "broken.yaml", []byte("steps:\n  - title: \"\"\n"))
+	assert.ErrorContains(t, err, "step 1")
+}
+
+func TestRenderTitle(t *testing.T) {
+	now := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		pattern  string
+		title    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "pattern", pattern: "Bug: {{title}}", title: "Login broken", expected: "Bug: Login broken"},
+		{name: "date", pattern: "Standup {{date}}", expected: "Standup 2025-03-10"},
+		{name: "fixed title replaced", pattern: "Weekly review", title: "Special review", expected: "Special review"},
+		{name: "no pattern", title: "Plain", expected: "Plain"},
+		{name: "missing title", pattern: "Bug: {{title}}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := &Template{Name: "t", Title: tt.pattern}
+			title, err := tmpl.RenderTitle(tt.title, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, title)
+		})
+	}
+}
+
+func TestFindAndList(t *testing.T) {
+	root := t.TempDir()
+	repoDir := filepath.Join(root, "repo", ".bc4", "templates", "cards")
+	userDir := filepath.Join(root, "config", "templates", "cards")
+	require.NoError(t, os.MkdirAll(repoDir, 0o755))
+	require.NoError(t, os.MkdirAll(userDir, 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "repo", "src"), 0o755))
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "bug.md"), []byte(bugTemplate), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(userDir, "bug.yaml"), []byte("title: Mine\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(userDir, "chore.yml"), []byte("title: Chore\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(userDir, "notes.txt"), []byte("ignored"), 0o644))
+
+	dirs := Dirs(filepath.Join(root, "config"), filepath.Join(root, "repo", "src"))
+	assert.Equal(t, []string{repoDir, userDir}, dirs)
+
+	tmpl, err := Find(dirs, "bug")
+	require.NoError(t, err)
+	assert.Equal(t, "Bug: {{title}}", tmpl.Title, "repository templates come first")
+
+	tmpl, err = Find(dirs, filepath.Join(userDir, "bug.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "Mine", tmpl.Title)
+
+	_, err = Find(dirs, "missing")
+	assert.ErrorContains(t, err, "not found")
+
+	templates, err := List(dirs)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "bug", templates[0].Name)
+	assert.Equal(t, filepath.Join(repoDir, "bug.md"), templates[0].Path)
+	assert.Equal(t, "chore", templates[1].Name)
+}
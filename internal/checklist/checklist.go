@@ -0,0 +1,356 @@
+// Package checklist reads todo checklists from Markdown, CSV and JSON files.
+package checklist
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Item is a single todo read from a checklist file
+type Item struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description,omitempty"`
+	Group       string   `json:"group,omitempty"`
+	Assignees   []string `json:"assignees,omitempty"`
+	DueOn       string   `json:"due_on,omitempty"`
+	Completed   bool     `json:"completed,omitempty"`
+
+	// ID is the Basecamp todo ID from a <!-- bc4:id --> marker, if any
+	ID int64 `json:"id,omitempty"`
+}
+
+// Format identifies a checklist file format
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatCSV      Format = "csv"
+	FormatJSON     Format = "json"
+)
+
+// ParseFormat parses a format name
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "csv":
+		return FormatCSV, nil
+	case "json":
+		return FormatJSON, nil
+	default:
+		return "", fmt.Errorf("invalid format %q: must be markdown, csv, or json", s)
+	}
+}
+
+// DetectFormat picks a format from a file name, defaulting to Markdown
+func DetectFormat(filename string) Format {
+	lower := strings.ToLower(filename)
+	switch {
+	case strings.HasSuffix(lower, ".csv"):
+		return FormatCSV
+	case strings.HasSuffix(lower, ".json"):
+		return FormatJSON
+	default:
+		return FormatMarkdown
+	}
+}
+
+// Parse reads items in the given format. columns maps item fields to CSV
+// header names and is ignored for other formats.
+func Parse(r io.Reader, format Format, columns map[string]string) ([]Item, error) {
+	switch format {
+	case FormatCSV:
+		return ParseCSV(r, columns)
+	case FormatJSON:
+		return ParseJSON(r)
+	default:
+		return ParseMarkdown(r)
+	}
+}
+
+var (
+	headingPattern  = regexp.MustCompile(`^#{1,6}\s+(.*?)\s*#*\s*$`)
+	checkboxPattern = regexp.MustCompile(`^( {0,1})[-*+]\s+\[([ xX])\]\s*(.*)$`)
+	markerPattern   = regexp.MustCompile(`<!--\s*bc4:(\d+)\s*-->`)
+	mentionPattern  = regexp.MustCompile(`(^|\s)@([\w.\-]*\w)`)
+	duePattern      = regexp.MustCompile(`(^|\s)due:(\S+)`)
+)
+
+// ParseMarkdown reads a GitHub-flavored Markdown checklist. Headings become
+// groups, "- [ ]" and "- [x]" items become todos, and indented lines below an
+// item become its description. In item titles, @name tokens are assignees
+// and due:YYYY-MM-DD sets the due date.
+func ParseMarkdown(r io.Reader) ([]Item, error) {
+	var items []Item
+	var current *Item
+	var description []string
+	group := ""
+	lineNo := 0
+
+	flush := func() {
+		if current == nil {
+			return
+		}
+		current.Description = dedent(description)
+		items = append(items, *current)
+		current = nil
+		description = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+
+		if m := headingPattern.FindStringSubmatch(line); m != nil {
+			flush()
+			group = m[1]
+			continue
+		}
+
+		if m := checkboxPattern.FindStringSubmatch(line); m != nil {
+			flush()
+			item, err := parseItemLine(m[3])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNo, err)
+			}
+			item.Group = group
+			item.Completed = m[2] != " "
+			current = &item
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "  ") || strings.HasPrefix(line, "\t") {
+			description = append(description, line)
+			continue
+		}
+
+		// Unindented text ends the current item
+		flush()
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read checklist: %w", err)
+	}
+	flush()
+
+	return items, nil
+}
+
+// parseItemLine extracts the title, assignees, due date and ID marker from
+// the text of a checklist item
+func parseItemLine(text string) (Item, error) {
+	var item Item
+
+	if m := markerPattern.FindStringSubmatch(text); m != nil {
+		item.ID, _ = strconv.ParseInt(m[1], 10, 64)
+		text = markerPattern.ReplaceAllString(text, "")
+	}
+
+	if m := duePattern.FindStringSubmatch(text); m != nil {
+		if err := validateDate(m[2]); err != nil {
+			return item, err
+		}
+		item.DueOn = m[2]
+		text = duePattern.ReplaceAllString(text, "$1")
+	}
+
+	for _, m := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		item.Assignees = append(item.Assignees, "@"+m[2])
+	}
+	text = mentionPattern.ReplaceAllString(text, "$1")
+
+	item.Title = strings.Join(strings.Fields(text), " ")
+	if item.Title == "" {
+		return item, fmt.Errorf("checklist item has no title")
+	}
+	return item, nil
+}
+
+// dedent strips the common indentation from description lines and trims
+// surrounding blank lines
+func dedent(lines []string) string {
+	indent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent == -1 || n < indent {
+			indent = n
+		}
+	}
+
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		if len(line) >= indent && indent > 0 {
+			line = line[indent:]
+		}
+		out[i] = line
+	}
+	return strings.TrimSpace(strings.Join(out, "\n"))
+}
+
+// columnAliases are the CSV headers recognized for each field when no
+// explicit mapping is given
+var columnAliases = map[string][]string{
+	"title":       {"title", "content", "todo", "task", "name"},
+	"description": {"description", "notes", "details"},
+	"group":       {"group", "section", "heading"},
+	"assignees":   {"assignees", "assignee", "assign", "assigned to"},
+	"due":         {"due", "due_on", "due on", "due date"},
+	"completed":   {"completed", "done", "status"},
+}
+
+// ParseCSV reads todos from a CSV file with a header row. columns maps item
+// fields (title, description, group, assignees, due, completed) to header
+// names; unmapped fields are matched against common header names.
+// Assignees may be separated by commas or semicolons.
+func ParseCSV(r io.Reader, columns map[string]string) ([]Item, error) {
+	for field := range columns {
+		if _, ok := columnAliases[field]; !ok {
+			return nil, fmt.Errorf("unknown column mapping %q: must be one of title, description, group, assignees, due, completed", field)
+		}
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	index := make(map[string]int)
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	fieldIndex := make(map[string]int)
+	for field, aliases := range columnAliases {
+		if name, ok := columns[field]; ok {
+			i, found := index[strings.ToLower(name)]
+			if !found {
+				return nil, fmt.Errorf("CSV has no %q column for %s", name, field)
+			}
+			fieldIndex[field] = i
+			continue
+		}
+		for _, alias := range aliases {
+			if i, found := index[alias]; found {
+				fieldIndex[field] = i
+				break
+			}
+		}
+	}
+	if _, ok := fieldIndex["title"]; !ok {
+		return nil, fmt.Errorf("CSV has no title column; map one with --map title=<header>")
+	}
+
+	var items []Item
+	row := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		get := func(field string) string {
+			i, ok := fieldIndex[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		item := Item{
+			Title:       get("title"),
+			Description: get("description"),
+			Group:       get("group"),
+			DueOn:       get("due"),
+			Completed:   isTruthy(get("completed")),
+		}
+		if item.Title == "" {
+			continue
+		}
+		if item.DueOn != "" {
+			if err := validateDate(item.DueOn); err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+		}
+		for _, name := range strings.FieldsFunc(get("assignees"), func(r rune) bool { return r == ',' || r == ';' }) {
+			if name = strings.TrimSpace(name); name != "" {
+				item.Assignees = append(item.Assignees, name)
+			}
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// ParseJSON reads an array of items
+func ParseJSON(r io.Reader) ([]Item, error) {
+	var items []Item
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	for i, item := range items {
+		if strings.TrimSpace(item.Title) == "" {
+			return nil, fmt.Errorf("item %d has no title", i+1)
+		}
+		if item.DueOn != "" {
+			if err := validateDate(item.DueOn); err != nil {
+				return nil, fmt.Errorf("item %d: %w", i+1, err)
+			}
+		}
+	}
+	return items, nil
+}
+
+func validateDate(s string) error {
+	if _, err := time.Parse("2006-01-02", s); err != nil {
+		return fmt.Errorf("invalid due date %q (use YYYY-MM-DD)", s)
+	}
+	return nil
+}
+
+func isTruthy(s string) bool {
+	switch strings.ToLower(s) {
+	case "1", "x", "y", "yes", "true", "done", "completed", "complete":
+		return true
+	}
+	return false
+}
+
+// Groups returns the distinct group names in order of first appearance,
+// excluding the empty (ungrouped) group
+func Groups(items []Item) []string {
+	var groups []string
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if item.Group != "" && !seen[item.Group] {
+			seen[item.Group] = true
+			groups = append(groups, item.Group)
+		}
+	}
+	return groups
+}
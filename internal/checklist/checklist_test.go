@@ -0,0 +1,130 @@
+package checklist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	input := `# Release 2.0
+
+Some intro text.
+
+- [ ] Tag the release @jane due:2025-03-01
+  Push the tag and wait for CI.
+
+  Then announce it.
+- [x] Write changelog <!-- bc4:42 -->
+
+## Backend
+* [ ] Run migrations @bob @alice
+    - [ ] nested step stays in the description
+- not a todo
+`
+
+	items, err := ParseMarkdown(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+
+	assert.Equal(t, Item{
+		Title:       "Tag the release",
+		Description: "Push the tag and wait for CI.\n\nThen announce it.",
+		Group:       "Release 2.0",
+		Assignees:   []string{"@jane"},
+		DueOn:       "2025-03-01",
+	}, items[0])
+
+	assert.Equal(t, "Write changelog", items[1].Title)
+	assert.True(t, items[1].Completed)
+	assert.Equal(t, int64(42), items[1].ID)
+
+	assert.Equal(t, "Run migrations", items[2].Title)
+	assert.Equal(t, "Backend", items[2].Group)
+	assert.Equal(t, []string{"@bob", "@alice"}, items[2].Assignees)
+	assert.Equal(t, "- [ ] nested step stays in the description", items[2].Description)
+}
+
+func TestParseMarkdownKeepsEmails(t *testing.T) {
+	items, err := ParseMarkdown(strings.NewReader("- [ ] Email ops@example.com about it"))
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Email ops@example.com about it", items[0].Title)
+	assert.Empty(t, items[0].Assignees)
+}
+
+func TestParseMarkdownInvalidDue(t *testing.T) {
+	_, err := ParseMarkdown(strings.NewReader("\n- [ ] Ship due:soon"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+func TestParseCSV(t *testing.T) {
+	input := "Task,Notes,Section,Owner,Due date,Done\n" +
+		"Ship it,Carefully,Backend,\"jane@example.com; bob\",2025-03-01,yes\n" +
+		",skipped,,,,\n" +
+		"Announce,,,,,\n"
+
+	items, err := ParseCSV(strings.NewReader(input), map[string]string{"assignees": "owner"})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	assert.Equal(t, Item{
+		Title:       "Ship it",
+		Description: "Carefully",
+		Group:       "Backend",
+		Assignees:   []string{"jane@example.com", "bob"},
+		DueOn:       "2025-03-01",
+		Completed:   true,
+	}, items[0])
+	assert.Equal(t, Item{Title: "Announce"}, items[1])
+}
+
+func TestParseCSVErrors(t *testing.T) {
+	_, err := ParseCSV(strings.NewReader("Summary\nfoo\n"), nil)
+	assert.ErrorContains(t, err, "no title column")
+
+	_, err = ParseCSV(strings.NewReader("Summary\nfoo\n"), map[string]string{"title": "Missing"})
+	assert.ErrorContains(t, err, `no "Missing" column`)
+
+	_, err = ParseCSV(strings.NewReader("Summary\nfoo\n"), map[string]string{"owner": "Summary"})
+	assert.ErrorContains(t, err, "unknown column mapping")
+
+	items, err := ParseCSV(strings.NewReader("Summary\nfoo\n"), map[string]string{"title": "summary"})
+	require.NoError(t, err)
+	assert.Equal(t, []Item{{Title: "foo"}}, items)
+}
+
+func TestParseJSON(t *testing.T) {
+	items, err := ParseJSON(strings.NewReader(`[{"title":"One","group":"G","assignees":["@jane"],"due_on":"2025-01-02","completed":true}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []Item{{Title: "One", Group: "G", Assignees: []string{"@jane"}, DueOn: "2025-01-02", Completed: true}}, items)
+
+	_, err = ParseJSON(strings.NewReader(`[{"description":"no title"}]`))
+	assert.ErrorContains(t, err, "item 1 has no title")
+}
+
+func TestDetectFormat(t *testing.T) {
+	assert.Equal(t, FormatCSV, DetectFormat("tasks.CSV"))
+	assert.Equal(t, FormatJSON, DetectFormat("tasks.json"))
+	assert.Equal(t, FormatMarkdown, DetectFormat("README.md"))
+	assert.Equal(t, FormatMarkdown, DetectFormat("-"))
+}
+
+func TestRenderRoundTrip(t *testing.T) {
+	items := []Item{
+		{ID: 1, Title: "Tag the release", DueOn: "2025-03-01", Description: "First line\n\n- a list"},
+		{ID: 2, Title: "Migrate", Group: "Backend", Completed: true},
+		{Title: "Announce", Group: "Comms", Assignees: []string{"@jane"}},
+	}
+
+	var buf strings.Builder
+	require.NoError(t, Render(&buf, items))
+	assert.Contains(t, buf.String(), "- [ ] Tag the release due:2025-03-01 <!-- bc4:1 -->\n  First line\n\n  - a list\n")
+	assert.Contains(t, buf.String(), "## Backend\n\n- [x] Migrate <!-- bc4:2 -->\n")
+
+	parsed, err := ParseMarkdown(strings.NewReader(buf.String()))
+	require.NoError(t, err)
+	assert.Equal(t, items, parsed)
+}
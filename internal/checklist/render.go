@@ -0,0 +1,63 @@
+package checklist
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Render writes items as a Markdown checklist that ParseMarkdown reads back.
+// Ungrouped items come first, then each group under a "##" heading. Items
+// with an ID get a <!-- bc4:id --> marker.
+func Render(w io.Writer, items []Item) error {
+	var b strings.Builder
+
+	writeItems := func(group string) {
+		for _, item := range items {
+			if item.Group == group {
+				renderItem(&b, item)
+			}
+		}
+	}
+
+	writeItems("")
+	for _, group := range Groups(items) {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "## %s\n\n", group)
+		writeItems(group)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func renderItem(b *strings.Builder, item Item) {
+	check := " "
+	if item.Completed {
+		check = "x"
+	}
+	fmt.Fprintf(b, "- [%s] %s", check, item.Title)
+	for _, assignee := range item.Assignees {
+		b.WriteString(" " + assignee)
+	}
+	if item.DueOn != "" {
+		b.WriteString(" due:" + item.DueOn)
+	}
+	if item.ID != 0 {
+		fmt.Fprintf(b, " <!-- bc4:%d -->", item.ID)
+	}
+	b.WriteString("\n")
+
+	if item.Description == "" {
+		return
+	}
+	for _, line := range strings.Split(item.Description, "\n") {
+		if strings.TrimSpace(line) == "" {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString("  " + line + "\n")
+	}
+}
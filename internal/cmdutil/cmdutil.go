@@ -16,6 +16,7 @@ const (
 	ExitSuccess    = 0   // Successful execution
 	ExitError      = 1   // General error
 	ExitUsageError = 2   // Invalid command usage
+	ExitIncomplete = 3   // Some of the work could not be checked or done
 	ExitAuthError  = 4   // Authentication failure
 	ExitNotFound   = 5   // Resource not found
 	ExitCanceled   = 130 // User canceled (Ctrl+C)
@@ -150,6 +151,34 @@ func IsSilentError(err error) bool {
 	return errors.As(err, &silentErr)
 }
 
+// ExitCodeError carries a specific exit code for the command's error
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitCodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitCodeError) Unwrap() error {
+	return e.Err
+}
+
+// NewExitCodeError wraps an error so the command exits with code
+func NewExitCodeError(code int, err error) error {
+	return &ExitCodeError{Code: code, Err: err}
+}
+
+// ExitCodeOf returns the exit code carried by an ExitCodeError in err's chain
+func ExitCodeOf(err error) (int, bool) {
+	var codeErr *ExitCodeError
+	if errors.As(err, &codeErr) {
+		return codeErr.Code, true
+	}
+	return 0, false
+}
+
 // UnwrapSilent returns the underlying error from a SilentError
 func UnwrapSilent(err error) error {
 	var silentErr *SilentError
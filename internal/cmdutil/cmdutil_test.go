@@ -368,3 +368,14 @@ func TestUnwrapSilent(t *testing.T) {
 		})
 	}
 }
+
+func TestExitCodeOf(t *testing.T) {
+	err := NewExitCodeError(ExitIncomplete, NewSilentError(errors.New("2 projects could not be scanned")))
+	code, ok := ExitCodeOf(fmt.Errorf("wrapped: %w", err))
+	assert.True(t, ok)
+	assert.Equal(t, ExitIncomplete, code)
+	assert.True(t, IsSilentError(err))
+
+	_, ok = ExitCodeOf(errors.New("plain"))
+	assert.False(t, ok)
+}
@@ -0,0 +1,92 @@
+package cmdutil
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/needmore/bc4/internal/utils"
+)
+
+// dateEcho is where resolved relative dates are reported
+var dateEcho io.Writer = os.Stderr
+
+// ResolveDate parses a date flag value such as "2025-03-01", "fri",
+// "next monday" or "+3d" in loc and returns it as YYYY-MM-DD. When the value
+// wasn't already an ISO date, the resolved date is echoed to stderr so the
+// user can see what it meant.
+func ResolveDate(label, value string, loc *time.Location) (string, error) {
+	t, err := utils.ParseDate(value, time.Now().In(loc))
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", strings.ToLower(label), err)
+	}
+
+	resolved := t.Format(utils.DateLayout)
+	if !utils.IsISODate(value) {
+		_, _ = fmt.Fprintf(dateEcho, "%s: %s (%s)\n", label, resolved, utils.FormatLongDate(t))
+	}
+	return resolved, nil
+}
+
+// ParseSince parses a --since value into a time.Time. Durations such
+// as 24h, 7d or 2w count back from now; "this week" and "last week" start on
+// Sunday; anything else goes through the shared date parser and must not be
+// in the future.
+func ParseSince(s string, now time.Time) (time.Time, error) {
+	// Trim spaces but preserve case for RFC3339 parsing
+	s = strings.TrimSpace(s)
+	sLower := strings.ToLower(s)
+
+	// Handle human-friendly durations
+	if strings.HasSuffix(sLower, "h") {
+		hours, err := parseDurationValue(strings.TrimSuffix(sLower, "h"))
+		if err == nil {
+			return now.Add(-time.Duration(hours) * time.Hour), nil
+		}
+	}
+	if strings.HasSuffix(sLower, "d") {
+		days, err := parseDurationValue(strings.TrimSuffix(sLower, "d"))
+		if err == nil {
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+	if strings.HasSuffix(sLower, "w") {
+		weeks, err := parseDurationValue(strings.TrimSuffix(sLower, "w"))
+		if err == nil {
+			return now.AddDate(0, 0, -weeks*7), nil
+		}
+	}
+
+	// Try parsing as RFC3339 (preserve original case)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	// Weeks start on Sunday
+	y, m, d := now.Date()
+	startOfWeek := time.Date(y, m, d-int(now.Weekday()), 0, 0, 0, 0, now.Location())
+	switch sLower {
+	case "this week":
+		return startOfWeek, nil
+	case "last week":
+		return startOfWeek.AddDate(0, 0, -7), nil
+	}
+
+	t, err := utils.ParseDate(s, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse time: %s", s)
+	}
+	if t.After(now) {
+		return time.Time{}, fmt.Errorf("%s is in the future", t.Format(utils.DateLayout))
+	}
+	return t, nil
+}
+
+// parseDurationValue parses an integer from a string
+func parseDurationValue(s string) (int, error) {
+	var v int
+	_, err := fmt.Sscanf(s, "%d", &v)
+	return v, err
+}
@@ -0,0 +1,162 @@
+package cmdutil
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveDate(t *testing.T) {
+	var echo bytes.Buffer
+	dateEcho = &echo
+	defer func() { dateEcho = os.Stderr }()
+
+	got, err := ResolveDate("Due date", "2025-03-01", time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-03-01", got)
+	assert.Empty(t, echo.String(), "ISO dates are not echoed")
+
+	got, err = ResolveDate("Due date", "today", time.UTC)
+	require.NoError(t, err)
+	assert.Equal(t, time.Now().UTC().Format("2006-01-02"), got)
+	assert.Contains(t, echo.String(), "Due date: "+got)
+
+	_, err = ResolveDate("Due date", "someday", time.UTC)
+	assert.ErrorContains(t, err, "invalid due date")
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{
+			name:    "hours",
+			input:   "24h",
+			wantErr: false,
+		},
+		{
+			name:    "days",
+			input:   "7d",
+			wantErr: false,
+		},
+		{
+			name:    "weeks",
+			input:   "2w",
+			wantErr: false,
+		},
+		{
+			name:    "RFC3339",
+			input:   "2024-01-01T00:00:00Z",
+			wantErr: false,
+		},
+		{
+			name:    "date only",
+			input:   "2024-01-01",
+			wantErr: false,
+		},
+		{
+			name:    "today",
+			input:   "today",
+			wantErr: false,
+		},
+		{
+			name:    "yesterday",
+			input:   "yesterday",
+			wantErr: false,
+		},
+		{
+			name:    "this week",
+			input:   "this week",
+			wantErr: false,
+		},
+		{
+			name:    "last week",
+			input:   "last week",
+			wantErr: false,
+		},
+		{
+			name:    "invalid",
+			input:   "invalid",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseSince(tt.input, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseSince() expected error for input %q, got nil", tt.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("ParseSince() unexpected error for input %q: %v", tt.input, err)
+				return
+			}
+
+			// Verify the result is before now
+			if !result.Before(now) {
+				t.Errorf("ParseSince() result should be before now for input %q", tt.input)
+			}
+		})
+	}
+}
+
+func TestParseDurationValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+		wantErr  bool
+	}{
+		{
+			name:     "single digit",
+			input:    "7",
+			expected: 7,
+			wantErr:  false,
+		},
+		{
+			name:     "multiple digits",
+			input:    "24",
+			expected: 24,
+			wantErr:  false,
+		},
+		{
+			name:     "invalid",
+			input:    "abc",
+			expected: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseDurationValue(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseDurationValue() expected error for input %q, got nil", tt.input)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("parseDurationValue() unexpected error for input %q: %v", tt.input, err)
+				return
+			}
+
+			if result != tt.expected {
+				t.Errorf("parseDurationValue(%q) = %d, expected %d", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package cmdutil
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/needmore/bc4/internal/factory"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// ResolveRecordingArg parses a recording ID or URL and applies any
+// account/project overrides carried by the URL to the factory
+func ResolveRecordingArg(f *factory.Factory, arg string) (*factory.Factory, int64, error) {
+	recordingID, parsedURL, err := parser.ParseArgument(arg)
+	if err != nil {
+		return f, 0, fmt.Errorf("invalid recording ID or URL: %s", arg)
+	}
+
+	if parsedURL != nil {
+		if parsedURL.ResourceType == parser.ResourceTypeProject || parsedURL.ResourceID == 0 {
+			return f, 0, fmt.Errorf("URL does not point to a recording: %s", arg)
+		}
+		if parsedURL.AccountID > 0 {
+			f = f.WithAccount(strconv.FormatInt(parsedURL.AccountID, 10))
+		}
+		if parsedURL.ProjectID > 0 {
+			f = f.WithProject(strconv.FormatInt(parsedURL.ProjectID, 10))
+		}
+	}
+
+	return f, recordingID, nil
+}
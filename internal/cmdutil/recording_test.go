@@ -0,0 +1,31 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/factory"
+)
+
+func TestResolveRecordingArg(t *testing.T) {
+	f := factory.New()
+
+	_, id, err := ResolveRecordingArg(f, "12345")
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), id)
+
+	newF, id, err := ResolveRecordingArg(f, "https://3.basecamp.com/1111/buckets/2222/todos/3333")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3333), id)
+	projectID, err := newF.ProjectID()
+	require.NoError(t, err)
+	assert.Equal(t, "2222", projectID)
+
+	_, _, err = ResolveRecordingArg(f, "https://3.basecamp.com/1111/projects/2222")
+	assert.Error(t, err)
+
+	_, _, err = ResolveRecordingArg(f, "not-an-id")
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/needmore/bc4/internal/utils"
 	"github.com/spf13/viper"
@@ -33,13 +34,17 @@ type ProjectDefaults struct {
 	DefaultTodoList  string `json:"default_todo_list,omitempty"`
 	DefaultCampfire  string `json:"default_campfire,omitempty"`
 	DefaultCardTable string `json:"default_card_table,omitempty"`
+	// WIPLimits caps the number of cards in card table columns, keyed by
+	// column ID
+	WIPLimits map[string]int `json:"wip_limits,omitempty"`
 }
 
 // PreferencesConfig represents user preferences
 type PreferencesConfig struct {
-	Editor string `json:"editor,omitempty"`
-	Pager  string `json:"pager,omitempty"`
-	Color  string `json:"color,omitempty"`
+	Editor   string `json:"editor,omitempty"`
+	Pager    string `json:"pager,omitempty"`
+	Color    string `json:"color,omitempty"`
+	Timezone string `json:"timezone,omitempty"`
 }
 
 var configDir string
@@ -145,10 +150,27 @@ func Load() (*Config, error) {
 	if projectID := viper.GetString("PROJECT_ID"); projectID != "" {
 		config.DefaultProject = projectID
 	}
+	if timezone := viper.GetString("TIMEZONE"); timezone != "" {
+		config.Preferences.Timezone = timezone
+	}
 
 	return &config, nil
 }
 
+// Location returns the time zone used to resolve dates: the configured
+// timezone preference (an IANA name such as "Europe/Berlin"), or the
+// system's local time zone
+func (c *Config) Location() (*time.Location, error) {
+	if c.Preferences.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(c.Preferences.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q in preferences: %w", c.Preferences.Timezone, err)
+	}
+	return loc, nil
+}
+
 // Save saves the configuration to file
 func Save(config *Config) error {
 	// Create directory if it doesn't exist
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/needmore/bc4/internal/api"
 	"github.com/needmore/bc4/internal/auth"
@@ -60,6 +61,15 @@ func (f *Factory) Config() (*config.Config, error) {
 	return f.config, f.configErr
 }
 
+// Location returns the time zone dates are resolved in
+func (f *Factory) Location() (*time.Location, error) {
+	cfg, err := f.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	return cfg.Location()
+}
+
 // AuthClient returns the auth client, creating it once if needed
 func (f *Factory) AuthClient() (*auth.Client, error) {
 	cfg, err := f.Config()
@@ -30,6 +30,7 @@ const (
 	ResourceTypeQuestionnaire  ResourceType = "questionnaire"
 	ResourceTypeQuestion       ResourceType = "question"
 	ResourceTypeQuestionAnswer ResourceType = "question_answer"
+	ResourceTypeWebhook        ResourceType = "webhook"
 	ResourceTypeUnknown        ResourceType = "unknown"
 )
 
@@ -314,6 +315,22 @@ var urlPatterns = []urlPattern{
 			}, nil
 		},
 	},
+	// Webhook pattern: /1234567/buckets/89012345/webhooks/34567890
+	{
+		regex:        regexp.MustCompile(`^/(\d+)/buckets/(\d+)/webhooks/(\d+)`),
+		resourceType: ResourceTypeWebhook,
+		extractor: func(matches []string) (*ParsedURL, error) {
+			accountID, _ := strconv.ParseInt(matches[1], 10, 64)
+			projectID, _ := strconv.ParseInt(matches[2], 10, 64)
+			webhookID, _ := strconv.ParseInt(matches[3], 10, 64)
+			return &ParsedURL{
+				AccountID:    accountID,
+				ProjectID:    projectID,
+				ResourceType: ResourceTypeWebhook,
+				ResourceID:   webhookID,
+			}, nil
+		},
+	},
 }
 
 // ParseBasecampURL parses a Basecamp URL and extracts relevant IDs
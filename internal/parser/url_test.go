@@ -150,6 +150,14 @@ func TestParseBasecampURL(t *testing.T) {
 			wantID:      45678901,
 			wantParent:  34567890,
 		},
+		{
+			name:        "webhook URL",
+			url:         "https://3.basecamp.com/1234567/buckets/89012345/webhooks/34567890",
+			wantAccount: 1234567,
+			wantProject: 89012345,
+			wantType:    ResourceTypeWebhook,
+			wantID:      34567890,
+		},
 		// Error cases
 		{
 			name:    "invalid URL",
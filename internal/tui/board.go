@@ -0,0 +1,941 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/markdown"
+)
+
+// BoardClient is the part of the Basecamp API used by the card board
+type BoardClient interface {
+	GetCardTable(ctx context.Context, projectID string, cardTableID int64) (*api.CardTable, error)
+	GetCardsInColumn(ctx context.Context, projectID string, columnID int64) ([]api.Card, error)
+	GetOnHoldCardsInColumn(ctx context.Context, onHoldCardsURL string) ([]api.Card, error)
+	GetCard(ctx context.Context, projectID string, cardID int64) (*api.Card, error)
+	UpdateCard(ctx context.Context, projectID string, cardID int64, req api.CardUpdateRequest) (*api.Card, error)
+	MoveCard(ctx context.Context, projectID string, cardID int64, columnID int64) error
+	ArchiveCard(ctx context.Context, projectID string, cardID int64) error
+	SetStepCompletion(ctx context.Context, projectID string, stepID int64, completed bool) error
+	GetProjectPeople(ctx context.Context, projectID string) ([]api.Person, error)
+	ListComments(ctx context.Context, projectID string, recordingID int64) ([]api.Comment, error)
+}
+
+// BoardColumn is a card table column with its cards. Active cards come
+// first, followed by the cards in the column's on-hold section.
+type BoardColumn struct {
+	Column api.Column
+	Cards  []api.Card
+}
+
+// onHoldCount returns the number of cards in the on-hold section
+func (c BoardColumn) onHoldCount() int {
+	n := 0
+	for _, card := range c.Cards {
+		if card.IsOnHold {
+			n++
+		}
+	}
+	return n
+}
+
+// LoadBoard fetches a card table and the cards in each of its columns,
+// including on-hold cards
+func LoadBoard(ctx context.Context, client BoardClient, projectID string, cardTableID int64) (*api.CardTable, []BoardColumn, error) {
+	table, err := client.GetCardTable(ctx, projectID, cardTableID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch card table: %w", err)
+	}
+
+	columns := make([]BoardColumn, 0, len(table.Lists))
+	for _, column := range table.Lists {
+		cards, err := client.GetCardsInColumn(ctx, projectID, column.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch cards in %s: %w", column.Title, err)
+		}
+		if column.OnHold.CardsURL != "" {
+			onHold, err := client.GetOnHoldCardsInColumn(ctx, column.OnHold.CardsURL)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to fetch on-hold cards in %s: %w", column.Title, err)
+			}
+			for i := range onHold {
+				onHold[i].IsOnHold = true
+			}
+			cards = append(cards, onHold...)
+		}
+		columns = append(columns, BoardColumn{Column: column, Cards: cards})
+	}
+
+	return table, columns, nil
+}
+
+type boardMode int
+
+const (
+	boardModeColumns boardMode = iota
+	boardModeDetail
+	boardModeAssign
+	boardModeConfirmArchive
+)
+
+type boardLoadedMsg struct {
+	table   *api.CardTable
+	columns []BoardColumn
+	err     error
+}
+
+type boardTickMsg struct{}
+
+type boardActionMsg struct {
+	status string
+	err    error
+}
+
+type cardDetailMsg struct {
+	card     *api.Card
+	comments []api.Comment
+	err      error
+}
+
+type boardPeopleMsg struct {
+	people []api.Person
+	err    error
+}
+
+// assigneeItem is a person in the assignee picker
+type assigneeItem struct {
+	person   api.Person
+	selected bool
+}
+
+func (i assigneeItem) Title() string {
+	if i.selected {
+		return "✓ " + i.person.Name
+	}
+	return "  " + i.person.Name
+}
+
+func (i assigneeItem) Description() string { return i.person.EmailAddress }
+func (i assigneeItem) FilterValue() string { return i.person.Name + " " + i.person.EmailAddress }
+
+// BoardModel is a full-screen kanban view of a card table
+type BoardModel struct {
+	ctx       context.Context
+	client    BoardClient
+	projectID string
+	tableID   int64
+	refresh   time.Duration
+
+	title       string
+	columns     []BoardColumn
+	col         int
+	row         int
+	loaded      bool
+	loading     bool
+	refreshedAt time.Time
+
+	mode          boardMode
+	returnMode    boardMode
+	detail        *api.Card
+	comments      []api.Comment
+	detailLoading bool
+	step          int
+	scroll        int
+
+	people      []api.Person
+	assignList  list.Model
+	assignCard  int64
+	statusLine  string
+	statusError bool
+	err         error
+
+	spinner spinner.Model
+	width   int
+	height  int
+}
+
+// NewBoardModel creates a board for a card table. The board reloads every
+// refresh interval; zero disables periodic refreshing.
+func NewBoardModel(ctx context.Context, client BoardClient, projectID string, cardTableID int64, refresh time.Duration) BoardModel {
+	s := spinner.New()
+	s.Spinner = spinner.Dot
+	s.Style = focusedStyle
+
+	assignList := list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
+	assignList.Title = "Assign people (space to toggle, enter to save)"
+	assignList.SetShowStatusBar(false)
+
+	return BoardModel{
+		ctx:        ctx,
+		client:     client,
+		projectID:  projectID,
+		tableID:    cardTableID,
+		refresh:    refresh,
+		loading:    true,
+		assignList: assignList,
+		spinner:    s,
+	}
+}
+
+// Err returns the error that stopped the board, if any
+func (m BoardModel) Err() error {
+	return m.err
+}
+
+// Init loads the board and starts the refresh timer
+func (m BoardModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.load(), m.tick())
+}
+
+func (m BoardModel) load() tea.Cmd {
+	return func() tea.Msg {
+		table, columns, err := LoadBoard(m.ctx, m.client, m.projectID, m.tableID)
+		return boardLoadedMsg{table: table, columns: columns, err: err}
+	}
+}
+
+func (m BoardModel) tick() tea.Cmd {
+	if m.refresh <= 0 {
+		return nil
+	}
+	return tea.Tick(m.refresh, func(time.Time) tea.Msg { return boardTickMsg{} })
+}
+
+// run performs a change in the background and reports the outcome
+func (m BoardModel) run(status string, fn func() error) tea.Cmd {
+	return func() tea.Msg {
+		if err := fn(); err != nil {
+			return boardActionMsg{err: err}
+		}
+		return boardActionMsg{status: status}
+	}
+}
+
+func (m *BoardModel) setStatus(status string, isError bool) {
+	m.statusLine = status
+	m.statusError = isError
+}
+
+// Update handles messages and key presses
+func (m BoardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.assignList.SetSize(msg.Width, msg.Height-2)
+		return m, nil
+
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
+
+	case boardTickMsg:
+		if m.mode == boardModeColumns && !m.loading {
+			m.loading = true
+			return m, tea.Batch(m.load(), m.tick())
+		}
+		return m, m.tick()
+
+	case boardLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			if !m.loaded {
+				m.err = msg.err
+				return m, tea.Quit
+			}
+			m.setStatus(msg.err.Error(), true)
+			return m, nil
+		}
+		selected := m.selectedCardID()
+		m.title = msg.table.Title
+		m.columns = msg.columns
+		m.loaded = true
+		m.refreshedAt = time.Now()
+		m.selectCard(selected)
+		return m, nil
+
+	case boardActionMsg:
+		if msg.err != nil {
+			m.setStatus(msg.err.Error(), true)
+		} else {
+			m.setStatus(msg.status, false)
+		}
+		m.loading = true
+		return m, m.load()
+
+	case cardDetailMsg:
+		m.detailLoading = false
+		if msg.err != nil {
+			m.mode = boardModeColumns
+			m.setStatus(msg.err.Error(), true)
+			return m, nil
+		}
+		m.detail = msg.card
+		m.comments = msg.comments
+		m.step = 0
+		m.scroll = 0
+		return m, nil
+
+	case boardPeopleMsg:
+		if msg.err != nil {
+			m.setStatus(fmt.Sprintf("failed to load people: %v", msg.err), true)
+			return m, nil
+		}
+		m.people = msg.people
+		m.openAssign()
+		return m, nil
+
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyCtrlC {
+			return m, tea.Quit
+		}
+		switch m.mode {
+		case boardModeDetail:
+			return m.updateDetail(msg)
+		case boardModeAssign:
+			return m.updateAssign(msg)
+		case boardModeConfirmArchive:
+			return m.updateConfirmArchive(msg)
+		default:
+			return m.updateColumns(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m BoardModel) updateColumns(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc":
+		return m, tea.Quit
+	case "left", "h":
+		if m.col > 0 {
+			m.col--
+			m.clampRow()
+		}
+	case "right", "l":
+		if m.col < len(m.columns)-1 {
+			m.col++
+			m.clampRow()
+		}
+	case "up", "k":
+		if m.row > 0 {
+			m.row--
+		}
+	case "down", "j":
+		m.row++
+		m.clampRow()
+	case "shift+left", "H", "<":
+		return m.moveSelected(-1)
+	case "shift+right", "L", ">":
+		return m.moveSelected(1)
+	case "o":
+		return m.toggleHold()
+	case "enter":
+		if card, ok := m.selectedCard(); ok {
+			m.mode = boardModeDetail
+			m.detail = nil
+			m.detailLoading = true
+			return m, m.loadDetail(card.ID)
+		}
+	case "a":
+		if card, ok := m.selectedCard(); ok {
+			return m.startAssign(card)
+		}
+	case "x":
+		if _, ok := m.selectedCard(); ok {
+			m.mode = boardModeConfirmArchive
+		}
+	case "r":
+		if !m.loading {
+			m.loading = true
+			m.setStatus("Refreshing…", false)
+			return m, m.load()
+		}
+	}
+	return m, nil
+}
+
+func (m BoardModel) updateDetail(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "esc", "backspace":
+		m.mode = boardModeColumns
+		m.detail = nil
+		m.comments = nil
+	case "up", "k":
+		if m.step > 0 {
+			m.step--
+		}
+	case "down", "j":
+		if m.detail != nil && m.step < len(m.detail.Steps)-1 {
+			m.step++
+		}
+	case "pgdown", "ctrl+d":
+		m.scroll += max(1, m.height/2)
+	case "pgup", "ctrl+u":
+		m.scroll = max(0, m.scroll-max(1, m.height/2))
+	case " ", "space", "x":
+		if m.detail == nil || m.step >= len(m.detail.Steps) {
+			return m, nil
+		}
+		step := &m.detail.Steps[m.step]
+		step.Completed = !step.Completed
+		completed, stepID, title := step.Completed, step.ID, step.Title
+		status := fmt.Sprintf("Checked %q", title)
+		if !completed {
+			status = fmt.Sprintf("Unchecked %q", title)
+		}
+		return m, m.run(status, func() error {
+			return m.client.SetStepCompletion(m.ctx, m.projectID, stepID, completed)
+		})
+	case "a":
+		if m.detail != nil {
+			return m.startAssign(*m.detail)
+		}
+	}
+	return m, nil
+}
+
+func (m BoardModel) updateAssign(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.assignList.FilterState() == list.Filtering {
+		var cmd tea.Cmd
+		m.assignList, cmd = m.assignList.Update(msg)
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		m.mode = m.returnMode
+		return m, nil
+	case " ", "space":
+		index := m.assignList.Index()
+		if item, ok := m.assignList.SelectedItem().(assigneeItem); ok {
+			item.selected = !item.selected
+			m.assignList.SetItem(index, item)
+		}
+		return m, nil
+	case "enter":
+		// Saving with nobody selected unassigns everyone
+		ids := []int64{}
+		var people []api.Person
+		for _, listItem := range m.assignList.Items() {
+			if item, ok := listItem.(assigneeItem); ok && item.selected {
+				ids = append(ids, item.person.ID)
+				people = append(people, item.person)
+			}
+		}
+		m.mode = m.returnMode
+		cardID := m.assignCard
+		m.updateCard(cardID, func(card *api.Card) { card.Assignees = people })
+		status := fmt.Sprintf("Assigned %d people to #%d", len(ids), cardID)
+		if len(ids) == 0 {
+			status = fmt.Sprintf("Unassigned everyone from #%d", cardID)
+		}
+		return m, m.run(status, func() error {
+			_, err := m.client.UpdateCard(m.ctx, m.projectID, cardID, api.CardUpdateRequest{AssigneeIDs: ids})
+			return err
+		})
+	}
+
+	var cmd tea.Cmd
+	m.assignList, cmd = m.assignList.Update(msg)
+	return m, cmd
+}
+
+func (m BoardModel) updateConfirmArchive(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.mode = boardModeColumns
+	if msg.String() != "y" && msg.String() != "Y" {
+		m.setStatus("Archive cancelled", false)
+		return m, nil
+	}
+
+	card, ok := m.selectedCard()
+	if !ok {
+		return m, nil
+	}
+	m.removeSelected()
+	return m, m.run(fmt.Sprintf("Archived %q", card.Title), func() error {
+		return m.client.ArchiveCard(m.ctx, m.projectID, card.ID)
+	})
+}
+
+// moveSelected moves the selected card delta columns to the left or right
+func (m BoardModel) moveSelected(delta int) (tea.Model, tea.Cmd) {
+	card, ok := m.selectedCard()
+	if !ok {
+		return m, nil
+	}
+	target := m.col + delta
+	if target < 0 || target >= len(m.columns) {
+		return m, nil
+	}
+
+	dest := m.columns[target].Column
+	m.removeSelected()
+	card.IsOnHold = false
+	m.columns[target].Cards = append([]api.Card{card}, m.columns[target].Cards...)
+	m.col, m.row = target, 0
+
+	return m, m.run(fmt.Sprintf("Moved %q to %s", card.Title, dest.Title), func() error {
+		return m.client.MoveCard(m.ctx, m.projectID, card.ID, dest.ID)
+	})
+}
+
+// toggleHold moves the selected card into or out of its column's on-hold
+// section
+func (m BoardModel) toggleHold() (tea.Model, tea.Cmd) {
+	card, ok := m.selectedCard()
+	if !ok {
+		return m, nil
+	}
+	column := m.columns[m.col].Column
+
+	destID := column.ID
+	status := fmt.Sprintf("Released %q from hold", card.Title)
+	if !card.IsOnHold {
+		if column.OnHold.ID == 0 {
+			m.setStatus(fmt.Sprintf("%s has no on-hold section", column.Title), true)
+			return m, nil
+		}
+		destID = column.OnHold.ID
+		status = fmt.Sprintf("Put %q on hold", card.Title)
+	}
+
+	m.removeSelected()
+	card.IsOnHold = !card.IsOnHold
+	cards := m.columns[m.col].Cards
+	if card.IsOnHold {
+		m.columns[m.col].Cards = append(cards, card)
+		m.row = len(cards)
+	} else {
+		m.columns[m.col].Cards = append([]api.Card{card}, cards...)
+		m.row = 0
+	}
+
+	return m, m.run(status, func() error {
+		return m.client.MoveCard(m.ctx, m.projectID, card.ID, destID)
+	})
+}
+
+func (m BoardModel) loadDetail(cardID int64) tea.Cmd {
+	return func() tea.Msg {
+		card, err := m.client.GetCard(m.ctx, m.projectID, cardID)
+		if err != nil {
+			return cardDetailMsg{err: fmt.Errorf("failed to load card: %w", err)}
+		}
+		comments, err := m.client.ListComments(m.ctx, m.projectID, cardID)
+		if err != nil {
+			return cardDetailMsg{err: fmt.Errorf("failed to load comments: %w", err)}
+		}
+		return cardDetailMsg{card: card, comments: comments}
+	}
+}
+
+func (m BoardModel) startAssign(card api.Card) (tea.Model, tea.Cmd) {
+	m.assignCard = card.ID
+	m.returnMode = m.mode
+	if m.people == nil {
+		m.setStatus("Loading people…", false)
+		return m, func() tea.Msg {
+			people, err := m.client.GetProjectPeople(m.ctx, m.projectID)
+			return boardPeopleMsg{people: people, err: err}
+		}
+	}
+	m.openAssign()
+	return m, nil
+}
+
+// openAssign fills the picker with project people, preselecting the
+// card's assignees
+func (m *BoardModel) openAssign() {
+	current := make(map[int64]bool)
+	if card, ok := m.findCard(m.assignCard); ok {
+		for _, person := range card.Assignees {
+			current[person.ID] = true
+		}
+	}
+
+	items := make([]list.Item, len(m.people))
+	for i, person := range m.people {
+		items[i] = assigneeItem{person: person, selected: current[person.ID]}
+	}
+	m.assignList.SetItems(items)
+	m.assignList.ResetFilter()
+	m.assignList.Select(0)
+	m.setStatus("", false)
+	m.mode = boardModeAssign
+}
+
+func (m BoardModel) selectedCard() (api.Card, bool) {
+	if m.col < 0 || m.col >= len(m.columns) {
+		return api.Card{}, false
+	}
+	cards := m.columns[m.col].Cards
+	if m.row < 0 || m.row >= len(cards) {
+		return api.Card{}, false
+	}
+	return cards[m.row], true
+}
+
+func (m BoardModel) selectedCardID() int64 {
+	if card, ok := m.selectedCard(); ok {
+		return card.ID
+	}
+	return 0
+}
+
+// findCard looks a card up on the board or in the detail pane
+func (m BoardModel) findCard(cardID int64) (api.Card, bool) {
+	if m.detail != nil && m.detail.ID == cardID {
+		return *m.detail, true
+	}
+	for _, column := range m.columns {
+		for _, card := range column.Cards {
+			if card.ID == cardID {
+				return card, true
+			}
+		}
+	}
+	return api.Card{}, false
+}
+
+// updateCard applies a change to a card wherever it is shown
+func (m *BoardModel) updateCard(cardID int64, change func(*api.Card)) {
+	if m.detail != nil && m.detail.ID == cardID {
+		change(m.detail)
+	}
+	for i := range m.columns {
+		for j := range m.columns[i].Cards {
+			if m.columns[i].Cards[j].ID == cardID {
+				change(&m.columns[i].Cards[j])
+			}
+		}
+	}
+}
+
+// selectCard restores the selection to a card after reloading, keeping the
+// current position when it's gone
+func (m *BoardModel) selectCard(cardID int64) {
+	if cardID != 0 {
+		for i, column := range m.columns {
+			for j, card := range column.Cards {
+				if card.ID == cardID {
+					m.col, m.row = i, j
+					return
+				}
+			}
+		}
+	}
+	if m.col >= len(m.columns) {
+		m.col = max(0, len(m.columns)-1)
+	}
+	m.clampRow()
+}
+
+func (m *BoardModel) removeSelected() {
+	cards := m.columns[m.col].Cards
+	m.columns[m.col].Cards = append(cards[:m.row:m.row], cards[m.row+1:]...)
+	m.clampRow()
+}
+
+func (m *BoardModel) clampRow() {
+	if m.col >= len(m.columns) {
+		m.row = 0
+		return
+	}
+	if n := len(m.columns[m.col].Cards); m.row >= n {
+		m.row = max(0, n-1)
+	}
+}
+
+// View renders the board
+func (m BoardModel) View() string {
+	if !m.loaded {
+		return fmt.Sprintf("\n  %s Loading card table…\n", m.spinner.View())
+	}
+
+	switch m.mode {
+	case boardModeDetail:
+		return m.detailView()
+	case boardModeAssign:
+		return m.assignList.View() + "\n" + m.statusView()
+	}
+	return m.boardView()
+}
+
+// columnColors maps Basecamp column colors to terminal colors
+var columnColors = map[string]lipgloss.Color{
+	"white":  "252",
+	"red":    "196",
+	"orange": "208",
+	"yellow": "220",
+	"green":  "42",
+	"blue":   "33",
+	"aqua":   "51",
+	"purple": "135",
+	"gray":   "245",
+	"pink":   "212",
+	"brown":  "130",
+}
+
+// ColumnColor returns the terminal color for a Basecamp column color,
+// defaulting to white
+func ColumnColor(color string) lipgloss.Color {
+	if c, ok := columnColors[color]; ok {
+		return c
+	}
+	return columnColors["white"]
+}
+
+const minBoardColumnWidth = 26
+
+func (m BoardModel) boardView() string {
+	width := m.width
+	if width <= 0 {
+		width = 100
+	}
+	height := m.height
+	if height <= 0 {
+		height = 30
+	}
+
+	total := 0
+	for _, column := range m.columns {
+		total += len(column.Cards)
+	}
+	header := titleStyle.Render(m.title) + "  " +
+		helpStyle.Render(fmt.Sprintf("%d cards · updated %s", total, m.refreshedAt.Format("15:04:05")))
+	if m.loading {
+		header += " " + m.spinner.View()
+	}
+
+	visible := max(1, min(len(m.columns), width/minBoardColumnWidth))
+	first := 0
+	if m.col >= visible {
+		first = m.col - visible + 1
+	}
+	colWidth := width / visible
+	bodyHeight := max(3, height-5)
+
+	var rendered []string
+	for i := first; i < len(m.columns) && i < first+visible; i++ {
+		rendered = append(rendered, m.columnView(i, colWidth, bodyHeight))
+	}
+	body := lipgloss.JoinHorizontal(lipgloss.Top, rendered...)
+
+	if len(m.columns) > visible {
+		header += helpStyle.Render(fmt.Sprintf("  columns %d–%d of %d", first+1, first+len(rendered), len(m.columns)))
+	}
+
+	return header + "\n" + body + "\n" + m.statusView()
+}
+
+func (m BoardModel) columnView(index, width, height int) string {
+	column := m.columns[index]
+	inner := max(4, width-2)
+	color := ColumnColor(column.Column.Color)
+	selectedColumn := index == m.col
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(color)
+	title := runewidth.Truncate(column.Column.Title, inner-6, "…")
+	count := len(column.Cards) - column.onHoldCount()
+	lines := []string{
+		headerStyle.Render(fmt.Sprintf("● %s", title)) + helpStyle.Render(fmt.Sprintf(" %d", count)),
+		lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("─", inner)),
+	}
+
+	var cardLines []string
+	selectedLine := 0
+	shownHold := false
+	for i, card := range column.Cards {
+		if card.IsOnHold && !shownHold {
+			shownHold = true
+			cardLines = append(cardLines, helpStyle.Render(fmt.Sprintf("⏸ On hold %d", column.onHoldCount())))
+		}
+		if selectedColumn && i == m.row {
+			selectedLine = len(cardLines)
+		}
+		cardLines = append(cardLines, m.cardLines(card, inner, selectedColumn && i == m.row)...)
+	}
+	if len(column.Cards) == 0 {
+		cardLines = append(cardLines, blurredStyle.Render("No cards"))
+	}
+
+	// Scroll so the selected card stays visible
+	space := height - len(lines)
+	start := 0
+	if selectedLine+3 > space {
+		start = selectedLine + 3 - space
+	}
+	end := min(len(cardLines), start+space)
+	if start > 0 {
+		lines = append(lines, helpStyle.Render(fmt.Sprintf("↑ %d more lines", start)))
+		start++
+	}
+	if start < end {
+		lines = append(lines, cardLines[start:end]...)
+	}
+
+	return lipgloss.NewStyle().Width(width).Height(height).Padding(0, 1).Render(strings.Join(lines, "\n"))
+}
+
+// cardLines renders a card as its title, a line of details and a spacer
+func (m BoardModel) cardLines(card api.Card, width int, selected bool) []string {
+	title := runewidth.Truncate(card.Title, width-2, "…")
+	if selected {
+		title = selectedItemStyle.Render("▌" + title)
+	} else {
+		title = normalItemStyle.Render(" " + title)
+	}
+
+	details := []string{fmt.Sprintf("#%d", card.ID)}
+	if len(card.Steps) > 0 {
+		done := 0
+		for _, step := range card.Steps {
+			if step.Completed {
+				done++
+			}
+		}
+		details = append(details, fmt.Sprintf("☑ %d/%d", done, len(card.Steps)))
+	}
+	if card.DueOn != nil && *card.DueOn != "" {
+		details = append(details, "due "+*card.DueOn)
+	}
+	if len(card.Assignees) > 0 {
+		details = append(details, initials(card.Assignees))
+	}
+	meta := helpStyle.Render(" " + runewidth.Truncate(strings.Join(details, " · "), width-2, "…"))
+
+	return []string{title, meta, ""}
+}
+
+// initials abbreviates assignee names, e.g. "JD, AB"
+func initials(people []api.Person) string {
+	parts := make([]string, 0, len(people))
+	for _, person := range people {
+		var b strings.Builder
+		for _, word := range strings.Fields(person.Name) {
+			b.WriteString(strings.ToUpper(string([]rune(word)[:1])))
+		}
+		parts = append(parts, b.String())
+	}
+	return strings.Join(parts, ",")
+}
+
+func (m BoardModel) detailView() string {
+	if m.detail == nil {
+		return fmt.Sprintf("\n  %s Loading card…\n", m.spinner.View())
+	}
+
+	width := m.width
+	if width <= 0 {
+		width = 100
+	}
+	wrap := lipgloss.NewStyle().Width(max(20, width-4))
+	converter := markdown.NewConverter()
+	card := m.detail
+
+	var b strings.Builder
+	b.WriteString(titleStyle.Render(card.Title) + "\n")
+
+	var meta []string
+	meta = append(meta, fmt.Sprintf("#%d", card.ID))
+	if card.Parent != nil {
+		meta = append(meta, card.Parent.Title)
+	}
+	if card.DueOn != nil && *card.DueOn != "" {
+		meta = append(meta, "due "+*card.DueOn)
+	}
+	b.WriteString(helpStyle.Render(strings.Join(meta, " · ")) + "\n")
+	if len(card.Assignees) > 0 {
+		names := make([]string, len(card.Assignees))
+		for i, person := range card.Assignees {
+			names[i] = person.Name
+		}
+		b.WriteString("Assigned to " + strings.Join(names, ", ") + "\n")
+	}
+
+	if content, err := converter.RichTextToMarkdown(card.Content); err == nil && strings.TrimSpace(content) != "" {
+		b.WriteString("\n" + wrap.Render(strings.TrimSpace(content)) + "\n")
+	}
+
+	if len(card.Steps) > 0 {
+		done := 0
+		for _, step := range card.Steps {
+			if step.Completed {
+				done++
+			}
+		}
+		b.WriteString("\n" + subtitleStyle.UnsetMarginBottom().Render(fmt.Sprintf("Steps %d/%d", done, len(card.Steps))) + "\n")
+		for i, step := range card.Steps {
+			check := "[ ]"
+			if step.Completed {
+				check = "[x]"
+			}
+			line := fmt.Sprintf("%s %s", check, step.Title)
+			if step.DueOn != nil && *step.DueOn != "" {
+				line += helpStyle.Render(" due " + *step.DueOn)
+			}
+			if len(step.Assignees) > 0 {
+				line += helpStyle.Render(" " + initials(step.Assignees))
+			}
+			if i == m.step {
+				b.WriteString(selectedItemStyle.Render("› ") + line + "\n")
+			} else {
+				b.WriteString("  " + line + "\n")
+			}
+		}
+	}
+
+	b.WriteString("\n" + subtitleStyle.UnsetMarginBottom().Render(fmt.Sprintf("Comments %d", len(m.comments))) + "\n")
+	for _, comment := range m.comments {
+		b.WriteString(focusedStyle.Render(comment.Creator.Name) + helpStyle.Render(" · "+comment.CreatedAt.Local().Format("Jan 2 15:04")) + "\n")
+		if content, err := converter.RichTextToMarkdown(comment.Content); err == nil {
+			b.WriteString(wrap.Render(strings.TrimSpace(content)) + "\n\n")
+		}
+	}
+
+	lines := strings.Split(b.String(), "\n")
+	height := m.height
+	if height <= 0 {
+		height = 30
+	}
+	space := max(1, height-2)
+	scroll := min(m.scroll, max(0, len(lines)-space))
+	end := min(len(lines), scroll+space)
+
+	return strings.Join(lines[scroll:end], "\n") + "\n" + m.statusView()
+}
+
+func (m BoardModel) statusView() string {
+	var help string
+	switch m.mode {
+	case boardModeDetail:
+		help = "↑/↓ step · space check · a assign · pgup/pgdn scroll · esc back"
+	case boardModeAssign:
+		help = "space toggle · / filter · enter save · esc cancel"
+	case boardModeConfirmArchive:
+		card, _ := m.selectedCard()
+		return errorStyle.Render(fmt.Sprintf("Archive %q? (y/N)", card.Title))
+	default:
+		help = "←/→ column · ↑/↓ card · H/L move · o hold · enter open · a assign · x archive · r refresh · q quit"
+	}
+
+	if m.statusLine == "" {
+		return helpStyle.Render(help)
+	}
+	if m.statusError {
+		return errorStyle.Render("✗ "+m.statusLine) + "  " + helpStyle.Render(help)
+	}
+	return successStyle.Render("✓ "+m.statusLine) + "  " + helpStyle.Render(help)
+}
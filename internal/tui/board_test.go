@@ -0,0 +1,250 @@
+package tui
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/api/mock"
+)
+
+func testBoard(t *testing.T) (BoardModel, *mock.MockClient) {
+	t.Helper()
+	client := mock.NewMockClient()
+	model := NewBoardModel(context.Background(), client, "1", 10, 0)
+
+	columns := []BoardColumn{
+		{
+			Column: api.Column{ID: 100, Title: "Triage", Color: "blue", OnHold: api.OnHoldStatus{ID: 101, Enabled: true}},
+			Cards: []api.Card{
+				{ID: 1, Title: "First"},
+				{ID: 2, Title: "Waiting", IsOnHold: true},
+			},
+		},
+		{
+			Column: api.Column{ID: 200, Title: "Doing"},
+			Cards:  []api.Card{{ID: 3, Title: "Third", Steps: []api.Step{{ID: 30, Title: "Step"}}}},
+		},
+	}
+	updated, _ := model.Update(boardLoadedMsg{table: &api.CardTable{Title: "Roadmap"}, columns: columns})
+	return updated.(BoardModel), client
+}
+
+func press(t *testing.T, m BoardModel, key string) (BoardModel, tea.Cmd) {
+	t.Helper()
+	var msg tea.KeyMsg
+	switch key {
+	case "enter":
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		msg = tea.KeyMsg{Type: tea.KeyEsc}
+	case " ":
+		msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	default:
+		msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	}
+	updated, cmd := m.Update(msg)
+	return updated.(BoardModel), cmd
+}
+
+func TestLoadBoard(t *testing.T) {
+	client := mock.NewMockClient()
+	client.CardTable = &api.CardTable{
+		ID:    10,
+		Title: "Roadmap",
+		Lists: []api.Column{
+			{ID: 100, Title: "Triage", OnHold: api.OnHoldStatus{CardsURL: "https://example.com/on_hold/cards.json"}},
+			{ID: 200, Title: "Done"},
+		},
+	}
+	client.Cards = []api.Card{{ID: 1, Title: "Active"}}
+	client.OnHoldCards = []api.Card{{ID: 2, Title: "Held"}}
+
+	table, columns, err := LoadBoard(context.Background(), client, "1", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "Roadmap", table.Title)
+	require.Len(t, columns, 2)
+
+	require.Len(t, columns[0].Cards, 2)
+	assert.False(t, columns[0].Cards[0].IsOnHold)
+	assert.True(t, columns[0].Cards[1].IsOnHold)
+	assert.Equal(t, 1, columns[0].onHoldCount())
+
+	assert.Len(t, columns[1].Cards, 1)
+
+	onHoldCalls := 0
+	for _, call := range client.Calls {
+		if strings.HasPrefix(call, "GetOnHoldCardsInColumn(") {
+			onHoldCalls++
+		}
+	}
+	assert.Equal(t, 1, onHoldCalls, "only columns with an on-hold section are queried")
+}
+
+func TestBoardModel_Navigation(t *testing.T) {
+	m, _ := testBoard(t)
+
+	m, _ = press(t, m, "j")
+	assert.Equal(t, 1, m.row)
+	m, _ = press(t, m, "j")
+	assert.Equal(t, 1, m.row, "selection stays on the last card")
+
+	m, _ = press(t, m, "l")
+	assert.Equal(t, 1, m.col)
+	assert.Equal(t, 0, m.row, "row is clamped to the new column")
+
+	m, _ = press(t, m, "l")
+	assert.Equal(t, 1, m.col)
+}
+
+func TestBoardModel_MoveCard(t *testing.T) {
+	m, client := testBoard(t)
+
+	m, cmd := press(t, m, "L")
+	require.NotNil(t, cmd)
+	assert.Equal(t, 1, m.col)
+	assert.Equal(t, int64(1), m.selectedCardID())
+	assert.Len(t, m.columns[0].Cards, 1)
+	assert.Len(t, m.columns[1].Cards, 2)
+
+	msg := cmd()
+	assert.Equal(t, boardActionMsg{status: `Moved "First" to Doing`}, msg)
+	assert.Contains(t, client.Calls, "MoveCard(1, 1, 200)")
+
+	_, cmd = press(t, m, "H")
+	require.NotNil(t, cmd)
+	cmd()
+	assert.Contains(t, client.Calls, "MoveCard(1, 1, 100)")
+}
+
+func TestBoardModel_ToggleHold(t *testing.T) {
+	m, client := testBoard(t)
+
+	m, cmd := press(t, m, "o")
+	require.NotNil(t, cmd)
+	assert.True(t, m.columns[0].Cards[1].IsOnHold)
+	assert.Equal(t, int64(1), m.selectedCardID())
+	cmd()
+	assert.Contains(t, client.Calls, "MoveCard(1, 1, 101)")
+
+	// Releasing moves the card back into the column
+	m, cmd = press(t, m, "o")
+	require.NotNil(t, cmd)
+	assert.Equal(t, 0, m.row)
+	cmd()
+	assert.Contains(t, client.Calls, "MoveCard(1, 1, 100)")
+
+	// Columns without an on-hold section report an error
+	m, _ = press(t, m, "l")
+	m, cmd = press(t, m, "o")
+	assert.Nil(t, cmd)
+	assert.True(t, m.statusError)
+	assert.Contains(t, m.statusLine, "Doing has no on-hold section")
+}
+
+func TestBoardModel_Archive(t *testing.T) {
+	m, client := testBoard(t)
+
+	m, _ = press(t, m, "x")
+	assert.Equal(t, boardModeConfirmArchive, m.mode)
+	assert.Contains(t, m.View(), `Archive "First"?`)
+
+	m, cmd := press(t, m, "n")
+	assert.Nil(t, cmd)
+	assert.Equal(t, boardModeColumns, m.mode)
+
+	m, _ = press(t, m, "x")
+	m, cmd = press(t, m, "y")
+	require.NotNil(t, cmd)
+	assert.Len(t, m.columns[0].Cards, 1)
+	cmd()
+	assert.Contains(t, client.Calls, "ArchiveCard(1, 1)")
+}
+
+func TestBoardModel_DetailSteps(t *testing.T) {
+	m, client := testBoard(t)
+	client.Card = &api.Card{ID: 3, Title: "Third", Steps: []api.Step{{ID: 30, Title: "Step"}}}
+	client.Comments = []api.Comment{{ID: 5, Content: "<div>Looks good</div>", Creator: api.Person{Name: "Ada"}}}
+
+	m, _ = press(t, m, "l")
+	m, cmd := press(t, m, "enter")
+	require.NotNil(t, cmd)
+	assert.Equal(t, boardModeDetail, m.mode)
+
+	updated, _ := m.Update(cmd())
+	m = updated.(BoardModel)
+	require.NotNil(t, m.detail)
+	view := m.View()
+	assert.Contains(t, view, "Steps 0/1")
+	assert.Contains(t, view, "Looks good")
+
+	m, cmd = press(t, m, " ")
+	require.NotNil(t, cmd)
+	assert.True(t, m.detail.Steps[0].Completed)
+	cmd()
+	assert.Contains(t, client.Calls, "SetStepCompletion(1, 30, true)")
+
+	m, _ = press(t, m, "esc")
+	assert.Equal(t, boardModeColumns, m.mode)
+}
+
+func TestBoardModel_Assign(t *testing.T) {
+	m, client := testBoard(t)
+	client.People = []api.Person{{ID: 7, Name: "Ada Lovelace"}, {ID: 8, Name: "Grace Hopper"}}
+
+	m, cmd := press(t, m, "a")
+	require.NotNil(t, cmd)
+	updated, _ := m.Update(cmd())
+	m = updated.(BoardModel)
+	assert.Equal(t, boardModeAssign, m.mode)
+
+	m, _ = press(t, m, " ")
+	m, cmd = press(t, m, "enter")
+	require.NotNil(t, cmd)
+	assert.Equal(t, boardModeColumns, m.mode)
+	assert.Equal(t, "AL", initials(m.columns[0].Cards[0].Assignees))
+	cmd()
+	assert.Contains(t, client.Calls, "UpdateCard(1, 1, {Title: Content: DueOn:<nil> AssigneeIDs:[7]})")
+
+	// Saving with nobody selected unassigns everyone
+	m, _ = press(t, m, "a")
+	assert.Equal(t, boardModeAssign, m.mode)
+	m, _ = press(t, m, " ")
+	m, cmd = press(t, m, "enter")
+	require.NotNil(t, cmd)
+	assert.Empty(t, m.columns[0].Cards[0].Assignees)
+	cmd()
+	assert.Contains(t, client.Calls, "UpdateCard(1, 1, {Title: Content: DueOn:<nil> AssigneeIDs:[]})")
+
+	body, err := json.Marshal(api.CardUpdateRequest{AssigneeIDs: []int64{}})
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"assignee_ids":[]`)
+}
+
+func TestBoardModel_View(t *testing.T) {
+	m, _ := testBoard(t)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = updated.(BoardModel)
+
+	view := m.View()
+	assert.Contains(t, view, "Roadmap")
+	assert.Contains(t, view, "Triage")
+	assert.Contains(t, view, "Doing")
+	assert.Contains(t, view, "On hold 1")
+	assert.Contains(t, view, "☑ 0/1")
+}
+
+func TestBoardModel_LoadErrorQuits(t *testing.T) {
+	model := NewBoardModel(context.Background(), mock.NewMockClient(), "1", 10, 0)
+	updated, cmd := model.Update(boardLoadedMsg{err: assert.AnError})
+	require.NotNil(t, cmd)
+	_, isQuit := cmd().(tea.QuitMsg)
+	assert.True(t, isQuit)
+	assert.Equal(t, assert.AnError, updated.(BoardModel).Err())
+}
@@ -0,0 +1,28 @@
+package ui
+
+import "strings"
+
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a row of block characters scaled from zero to
+// the largest value. Zero renders as the lowest tick so the line stays
+// continuous.
+func Sparkline(values []int) string {
+	maxValue := 0
+	for _, v := range values {
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		if v <= 0 || maxValue == 0 {
+			b.WriteRune(sparkTicks[0])
+			continue
+		}
+		i := (v*(len(sparkTicks)-1) + maxValue/2) / maxValue
+		b.WriteRune(sparkTicks[i])
+	}
+	return b.String()
+}
@@ -9,10 +9,11 @@ import (
 
 // TablePrinter provides bc4-specific table functionality wrapping the core tableprinter
 type TablePrinter struct {
-	core   tableprinter.TablePrinter
-	cs     *tableprinter.ColorScheme
-	isTTY  bool
-	writer io.Writer
+	core     tableprinter.TablePrinter
+	cs       *tableprinter.ColorScheme
+	isTTY    bool
+	writer   io.Writer
+	maxWidth int
 }
 
 // New creates a new bc4 table printer with automatic TTY detection
@@ -21,20 +22,22 @@ func New(writer io.Writer) *TablePrinter {
 	maxWidth := tableprinter.GetTerminalWidth()
 
 	return &TablePrinter{
-		core:   tableprinter.New(writer, isTTY, maxWidth),
-		cs:     tableprinter.NewColorScheme(),
-		isTTY:  isTTY,
-		writer: writer,
+		core:     tableprinter.New(writer, isTTY, maxWidth),
+		cs:       tableprinter.NewColorScheme(),
+		isTTY:    isTTY,
+		writer:   writer,
+		maxWidth: maxWidth,
 	}
 }
 
 // NewWithOptions creates a table printer with specific options
 func NewWithOptions(writer io.Writer, isTTY bool, maxWidth int) *TablePrinter {
 	return &TablePrinter{
-		core:   tableprinter.New(writer, isTTY, maxWidth),
-		cs:     tableprinter.NewColorScheme(),
-		isTTY:  isTTY,
-		writer: writer,
+		core:     tableprinter.New(writer, isTTY, maxWidth),
+		cs:       tableprinter.NewColorScheme(),
+		isTTY:    isTTY,
+		writer:   writer,
+		maxWidth: maxWidth,
 	}
 }
 
@@ -145,6 +148,11 @@ func (t *TablePrinter) GetColorScheme() *tableprinter.ColorScheme {
 	return t.cs
 }
 
+// MaxWidth returns the total width available to the table
+func (t *TablePrinter) MaxWidth() int {
+	return t.maxWidth
+}
+
 // IsTTY returns whether the output is a terminal
 func (t *TablePrinter) IsTTY() bool {
 	return t.isTTY
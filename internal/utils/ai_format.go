@@ -118,6 +118,7 @@ func FormatCardAsMarkdown(card *api.Card, comments []api.Comment) (string, error
 				return "", fmt.Errorf("failed to convert comment content to markdown: %w", err)
 			}
 			fmt.Fprintf(&buf, "%s\n", commentMd)
+			writeBoostsMarkdown(&buf, comment.Boosts, comment.BoostsCount)
 		}
 	}
 
@@ -170,6 +171,7 @@ func FormatTodoAsMarkdown(todo *api.Todo, comments []api.Comment) (string, error
 	}
 
 	fmt.Fprintf(&buf, "- **Todolist ID:** %d\n", todo.TodolistID)
+	writeBoostsMetadata(&buf, todo.Boosts, todo.BoostsCount)
 
 	// Description
 	if todo.Description != "" {
@@ -195,6 +197,7 @@ func FormatTodoAsMarkdown(todo *api.Todo, comments []api.Comment) (string, error
 				return "", fmt.Errorf("failed to convert comment content to markdown: %w", err)
 			}
 			fmt.Fprintf(&buf, "%s\n", commentMd)
+			writeBoostsMarkdown(&buf, comment.Boosts, comment.BoostsCount)
 		}
 	}
 
@@ -224,6 +227,7 @@ func FormatMessageAsMarkdown(message *api.Message, comments []api.Comment) (stri
 	}
 
 	fmt.Fprintf(&buf, "- **URL:** %s\n", message.URL)
+	writeBoostsMetadata(&buf, message.Boosts, message.BoostsCount)
 
 	// Content
 	if message.Content != "" {
@@ -249,6 +253,7 @@ func FormatMessageAsMarkdown(message *api.Message, comments []api.Comment) (stri
 				return "", fmt.Errorf("failed to convert comment content to markdown: %w", err)
 			}
 			fmt.Fprintf(&buf, "%s\n", commentMd)
+			writeBoostsMarkdown(&buf, comment.Boosts, comment.BoostsCount)
 		}
 	}
 
@@ -299,8 +304,27 @@ func FormatDocumentAsMarkdown(document *api.Document, comments []api.Comment) (s
 				return "", fmt.Errorf("failed to convert comment content to markdown: %w", err)
 			}
 			fmt.Fprintf(&buf, "%s\n", commentMd)
+			writeBoostsMarkdown(&buf, comment.Boosts, comment.BoostsCount)
 		}
 	}
 
 	return buf.String(), nil
 }
+
+// writeBoostsMetadata adds a boosts line to a metadata list
+func writeBoostsMetadata(buf *strings.Builder, boosts []api.Boost, count int) {
+	if summary := FormatBoostSummary(boosts); summary != "" {
+		fmt.Fprintf(buf, "- **Boosts:** %s\n", summary)
+	} else if count > 0 {
+		fmt.Fprintf(buf, "- **Boosts:** %d\n", count)
+	}
+}
+
+// writeBoostsMarkdown adds a boosts line below a comment
+func writeBoostsMarkdown(buf *strings.Builder, boosts []api.Boost, count int) {
+	if summary := FormatBoostSummary(boosts); summary != "" {
+		fmt.Fprintf(buf, "\n*Boosts: %s*\n", summary)
+	} else if count > 0 {
+		fmt.Fprintf(buf, "\n*Boosts: %d*\n", count)
+	}
+}
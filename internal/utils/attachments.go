@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/attachments"
+)
+
+// RehostAttachments re-uploads the files embedded in rich text content so it
+// can be posted to another project. Attachments that can't be downloaded
+// through the API keep their original tag and are reported in the returned
+// errors, so callers can warn without losing the rest of the content.
+func RehostAttachments(ctx context.Context, uploads api.UploadOperations, uploader api.AttachmentOperations, sourceProjectID, content string) (string, []error) {
+	if len(attachments.ParseAttachments(content)) == 0 {
+		return content, nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "bc4-rehost-")
+	if err != nil {
+		return content, []error{fmt.Errorf("failed to create temp directory: %w", err)}
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	var errs []error
+	rehosted := attachments.ReplaceAttachments(content, func(tag string, att attachments.Attachment) string {
+		sgid, err := rehostAttachment(ctx, uploads, uploader, sourceProjectID, tmpDir, att)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", att.GetDisplayName(), err))
+			return tag
+		}
+		return attachments.BuildTag(sgid)
+	})
+
+	return rehosted, errs
+}
+
+func rehostAttachment(ctx context.Context, uploads api.UploadOperations, uploader api.AttachmentOperations, sourceProjectID, tmpDir string, att attachments.Attachment) (string, error) {
+	result, err := attachments.TryExtractUploadID(&att)
+	if err != nil {
+		return "", err
+	}
+
+	bucketID := sourceProjectID
+	if id, err := attachments.ExtractBucketID(result.SourceURL); err == nil {
+		bucketID = id
+	}
+
+	upload, err := uploads.GetUpload(ctx, bucketID, result.UploadID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get upload details: %w", err)
+	}
+
+	destPath := filepath.Join(tmpDir, fmt.Sprintf("%d", upload.ID))
+	if err := uploads.DownloadAttachment(ctx, upload.DownloadURL, destPath); err != nil {
+		return "", err
+	}
+
+	data, err := os.ReadFile(destPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read downloaded file: %w", err)
+	}
+
+	resp, err := uploader.UploadAttachment(upload.Filename, data, upload.ContentType)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.AttachableSGID, nil
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// FormatBoostSummary groups boosts by content in the order they were first
+// given, e.g. "🎉 Jane, Bob · 👍 Alex"
+func FormatBoostSummary(boosts []api.Boost) string {
+	if len(boosts) == 0 {
+		return ""
+	}
+
+	var order []string
+	names := make(map[string][]string)
+	for _, boost := range boosts {
+		content := strings.TrimSpace(boost.Content)
+		if _, seen := names[content]; !seen {
+			order = append(order, content)
+		}
+		names[content] = append(names[content], boost.Booster.Name)
+	}
+
+	parts := make([]string, len(order))
+	for i, content := range order {
+		parts[i] = fmt.Sprintf("%s %s", content, strings.Join(names[content], ", "))
+	}
+
+	return strings.Join(parts, " · ")
+}
+
+// LoadCommentBoosts fetches boosts for every comment that has any and stores
+// them on the comment so formatters can render them
+func LoadCommentBoosts(ctx context.Context, boostOps api.BoostOperations, projectID string, comments []api.Comment) error {
+	for i := range comments {
+		if comments[i].BoostsCount == 0 {
+			continue
+		}
+
+		boosts, err := boostOps.ListBoosts(ctx, projectID, comments[i].ID)
+		if err != nil {
+			return err
+		}
+		comments[i].Boosts = boosts
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+type fakeBoostOps struct {
+	api.BoostOperations
+	boosts map[int64][]api.Boost
+	calls  []int64
+}
+
+func (f *fakeBoostOps) ListBoosts(ctx context.Context, projectID string, recordingID int64) ([]api.Boost, error) {
+	f.calls = append(f.calls, recordingID)
+	return f.boosts[recordingID], nil
+}
+
+func boost(content, name string) api.Boost {
+	return api.Boost{Content: content, Booster: api.Person{Name: name}}
+}
+
+func TestFormatBoostSummary(t *testing.T) {
+	if got := FormatBoostSummary(nil); got != "" {
+		t.Errorf("Expected empty summary, got %q", got)
+	}
+
+	boosts := []api.Boost{
+		boost("🎉", "Jane"),
+		boost("👍", "Alex"),
+		boost("🎉", "Bob"),
+	}
+
+	want := "🎉 Jane, Bob · 👍 Alex"
+	if got := FormatBoostSummary(boosts); got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestLoadCommentBoosts(t *testing.T) {
+	ops := &fakeBoostOps{boosts: map[int64][]api.Boost{
+		2: {boost("🔥", "Jane")},
+	}}
+
+	comments := []api.Comment{
+		{ID: 1},
+		{ID: 2, BoostsCount: 1},
+	}
+
+	if err := LoadCommentBoosts(context.Background(), ops, "123", comments); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(ops.calls) != 1 || ops.calls[0] != 2 {
+		t.Errorf("Expected boosts to be fetched only for comment 2, got %v", ops.calls)
+	}
+	if len(comments[1].Boosts) != 1 {
+		t.Errorf("Expected boosts on comment 2, got %v", comments[1].Boosts)
+	}
+}
+
+func TestFormatCommentsForDisplayWithBoosts(t *testing.T) {
+	comments := []api.Comment{
+		{
+			ID:          123,
+			Content:     "Shipped!",
+			CreatedAt:   time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC),
+			Creator:     api.Person{Name: "John Doe"},
+			BoostsCount: 2,
+			Boosts:      []api.Boost{boost("🎉", "Jane"), boost("🎉", "Bob")},
+		},
+	}
+
+	result, err := FormatCommentsForDisplay(comments)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(result, "Boosts: 🎉 Jane, Bob") {
+		t.Errorf("Expected boost summary, got %q", result)
+	}
+}
+
+func TestFormatTodoAsMarkdownWithBoosts(t *testing.T) {
+	todo := &api.Todo{
+		ID:     1,
+		Title:  "Launch",
+		Boosts: []api.Boost{boost("🚀", "Jane")},
+	}
+	comments := []api.Comment{
+		{ID: 2, Content: "<div>Nice</div>", Creator: api.Person{Name: "Bob"}, BoostsCount: 3},
+	}
+
+	result, err := FormatTodoAsMarkdown(todo, comments)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(result, "- **Boosts:** 🚀 Jane") {
+		t.Errorf("Expected todo boost summary, got %q", result)
+	}
+	if !strings.Contains(result, "*Boosts: 3*") {
+		t.Errorf("Expected comment boost count, got %q", result)
+	}
+}
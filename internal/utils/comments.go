@@ -3,6 +3,7 @@ package utils
 import (
 	"bytes"
 	"fmt"
+	"html"
 	"strings"
 
 	"github.com/charmbracelet/glamour"
@@ -37,26 +38,44 @@ func FormatCommentsForDisplay(comments []api.Comment) (string, error) {
 			comment.Creator.Name,
 			comment.CreatedAt.Format("Jan 2, 2006 at 3:04 PM"))))
 
-		// Render content with glamour
-		r, err := glamour.NewTermRenderer(
-			glamour.WithAutoStyle(),
-			glamour.WithWordWrap(80),
-		)
-		if err != nil {
-			// Fallback to plain text if glamour fails
-			fmt.Fprintf(&buf, "%s\n", comment.Content)
-			continue
-		}
+		// Render content with glamour, falling back to plain text
+		fmt.Fprint(&buf, renderCommentContent(comment.Content))
 
-		rendered, err := r.Render(comment.Content)
-		if err != nil {
-			// Fallback to plain text if rendering fails
-			fmt.Fprintf(&buf, "%s\n", comment.Content)
-			continue
+		// Boost summary
+		if summary := FormatBoostSummary(comment.Boosts); summary != "" {
+			fmt.Fprintf(&buf, "%s\n", metaStyle.Render("Boosts: "+summary))
+		} else if comment.BoostsCount > 0 {
+			fmt.Fprintf(&buf, "%s\n", metaStyle.Render(fmt.Sprintf("%d boosts", comment.BoostsCount)))
 		}
-
-		fmt.Fprint(&buf, rendered)
 	}
 
 	return buf.String(), nil
 }
+
+// renderCommentContent renders comment content with glamour, falling back to
+// plain text if rendering fails
+func renderCommentContent(content string) string {
+	r, err := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+		glamour.WithWordWrap(80),
+	)
+	if err != nil {
+		return content + "\n"
+	}
+
+	rendered, err := r.Render(content)
+	if err != nil {
+		return content + "\n"
+	}
+
+	return rendered
+}
+
+// QuoteComment renders a comment as quoted history, attributed to its
+// original author and date, for re-posting on a copied recording
+func QuoteComment(comment api.Comment, content string) string {
+	return fmt.Sprintf("<div><em>%s wrote on %s:</em></div><blockquote>%s</blockquote>",
+		html.EscapeString(comment.Creator.Name),
+		comment.CreatedAt.Local().Format("Jan 2, 2006 3:04 PM"),
+		content)
+}
@@ -94,3 +94,16 @@ func TestFormatCommentsForDisplay(t *testing.T) {
 		}
 	})
 }
+
+func TestQuoteComment(t *testing.T) {
+	comment := api.Comment{
+		Creator:   api.Person{Name: "Jane <Admin>"},
+		CreatedAt: time.Date(2024, 3, 5, 14, 30, 0, 0, time.Local),
+	}
+
+	got := QuoteComment(comment, "<div>Looks good</div>")
+	expected := "<div><em>Jane &lt;Admin&gt; wrote on Mar 5, 2024 2:30 PM:</em></div><blockquote><div>Looks good</div></blockquote>"
+	if got != expected {
+		t.Errorf("QuoteComment() = %q, want %q", got, expected)
+	}
+}
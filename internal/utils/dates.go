@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateLayout is the YYYY-MM-DD format Basecamp uses for dates
+const DateLayout = "2006-01-02"
+
+var (
+	relativeDatePattern = regexp.MustCompile(`^([+-]?)(\d+)\s*(d|day|days|w|wk|week|weeks|m|mo|month|months|y|yr|year|years)$`)
+	inDatePattern       = regexp.MustCompile(`^in (\d+) (day|days|week|weeks|month|months|year|years)$`)
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// ParseDate resolves a date expression relative to now, returning midnight
+// of that day in now's location. It accepts:
+//   - ISO dates (2025-03-01) and RFC3339 timestamps
+//   - today, tomorrow, yesterday
+//   - weekday names (fri, friday): the next such day, today included
+//   - next <weekday>: the next such day after today
+//   - next week, next month, next year
+//   - offsets such as +3d, -1w, 2m, "in 3 days"
+//   - end of week (Friday), end of month, end of year
+//
+// Hyphens may stand in for spaces, as in "next-monday".
+func ParseDate(input string, now time.Time) (time.Time, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return time.Time{}, fmt.Errorf("empty date")
+	}
+
+	loc := now.Location()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	if t, err := time.ParseInLocation(DateLayout, s, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		t = t.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc), nil
+	}
+
+	phrase := strings.ToLower(s)
+	if first := phrase[0]; first < '0' || first > '9' {
+		if first != '+' && first != '-' {
+			phrase = strings.ReplaceAll(phrase, "-", " ")
+		}
+	}
+	phrase = strings.Join(strings.Fields(phrase), " ")
+
+	switch phrase {
+	case "today", "now":
+		return today, nil
+	case "tomorrow", "tmr", "tmrw":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	case "next week":
+		return today.AddDate(0, 0, 7), nil
+	case "next month":
+		return today.AddDate(0, 1, 0), nil
+	case "next year":
+		return today.AddDate(1, 0, 0), nil
+	case "end of week", "eow":
+		return nextWeekday(today, time.Friday, true), nil
+	case "end of month", "eom":
+		return time.Date(today.Year(), today.Month()+1, 0, 0, 0, 0, 0, loc), nil
+	case "end of year", "eoy":
+		return time.Date(today.Year(), time.December, 31, 0, 0, 0, 0, loc), nil
+	}
+
+	if day, ok := weekdays[phrase]; ok {
+		return nextWeekday(today, day, true), nil
+	}
+	if name, ok := strings.CutPrefix(phrase, "next "); ok {
+		if day, ok := weekdays[name]; ok {
+			return nextWeekday(today, day, false), nil
+		}
+	}
+
+	if m := inDatePattern.FindStringSubmatch(phrase); m != nil {
+		phrase = "+" + m[1] + m[2]
+	}
+	if m := relativeDatePattern.FindStringSubmatch(strings.ReplaceAll(phrase, " ", "")); m != nil {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid date offset %q", input)
+		}
+		if m[1] == "-" {
+			n = -n
+		}
+		switch m[3][0] {
+		case 'd':
+			return today.AddDate(0, 0, n), nil
+		case 'w':
+			return today.AddDate(0, 0, 7*n), nil
+		case 'm':
+			return today.AddDate(0, n, 0), nil
+		default:
+			return today.AddDate(n, 0, 0), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q (use YYYY-MM-DD, today, tomorrow, fri, next monday, +3d, or end of month)", input)
+}
+
+// nextWeekday returns the next day falling on weekday, counting today when
+// includeToday is set
+func nextWeekday(today time.Time, weekday time.Weekday, includeToday bool) time.Time {
+	days := (int(weekday) - int(today.Weekday()) + 7) % 7
+	if days == 0 && !includeToday {
+		days = 7
+	}
+	return today.AddDate(0, 0, days)
+}
+
+// IsISODate reports whether s is already a YYYY-MM-DD date
+func IsISODate(s string) bool {
+	_, err := time.Parse(DateLayout, strings.TrimSpace(s))
+	return err == nil
+}
+
+// FormatLongDate formats a date for confirmation messages, e.g. "Fri, Mar 7, 2025"
+func FormatLongDate(t time.Time) string {
+	return t.Format("Mon, Jan 2, 2006")
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	// Wednesday afternoon in New York
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	now := time.Date(2024, 5, 15, 14, 30, 0, 0, ny)
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"2024-06-01", "2024-06-01"},
+		{"2024-06-01T02:00:00Z", "2024-05-31"},
+		{"today", "2024-05-15"},
+		{"Tomorrow", "2024-05-16"},
+		{"yesterday", "2024-05-14"},
+		{"fri", "2024-05-17"},
+		{"friday", "2024-05-17"},
+		{"wed", "2024-05-15"},
+		{"next wednesday", "2024-05-22"},
+		{"next monday", "2024-05-20"},
+		{"next-monday", "2024-05-20"},
+		{"next week", "2024-05-22"},
+		{"next month", "2024-06-15"},
+		{"+3d", "2024-05-18"},
+		{"3d", "2024-05-18"},
+		{"-1w", "2024-05-08"},
+		{"+2 weeks", "2024-05-29"},
+		{"in 10 days", "2024-05-25"},
+		{"+1m", "2024-06-15"},
+		{"end of week", "2024-05-17"},
+		{"end of month", "2024-05-31"},
+		{"EOM", "2024-05-31"},
+		{"end of year", "2024-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDate(tt.input, now)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) returned error: %v", tt.input, err)
+			}
+			if got.Format(DateLayout) != tt.want {
+				t.Errorf("ParseDate(%q) = %s, want %s", tt.input, got.Format(DateLayout), tt.want)
+			}
+			if got.Location() != ny {
+				t.Errorf("ParseDate(%q) location = %s, want %s", tt.input, got.Location(), ny)
+			}
+		})
+	}
+}
+
+func TestParseDateInvalid(t *testing.T) {
+	now := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	for _, input := range []string{"", "someday", "2024-13-01", "next", "+3x"} {
+		if _, err := ParseDate(input, now); err == nil {
+			t.Errorf("ParseDate(%q) expected error", input)
+		}
+	}
+}
+
+func TestIsISODate(t *testing.T) {
+	if !IsISODate("2024-05-15") {
+		t.Error("expected 2024-05-15 to be an ISO date")
+	}
+	if IsISODate("tomorrow") {
+		t.Error("expected tomorrow not to be an ISO date")
+	}
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"time"
+)
+
+// DueBucket groups work items by how soon they are due
+type DueBucket int
+
+// Due buckets in display order
+const (
+	DueOverdue DueBucket = iota
+	DueToday
+	DueThisWeek
+	DueLater
+	DueNoDate
+)
+
+// DueBuckets lists every bucket in display order
+var DueBuckets = []DueBucket{DueOverdue, DueToday, DueThisWeek, DueLater, DueNoDate}
+
+// String returns the heading used when displaying a bucket
+func (b DueBucket) String() string {
+	switch b {
+	case DueOverdue:
+		return "Overdue"
+	case DueToday:
+		return "Today"
+	case DueThisWeek:
+		return "This week"
+	case DueLater:
+		return "Later"
+	default:
+		return "No date"
+	}
+}
+
+// Slug returns a machine-friendly name for a bucket, used in JSON and CSV output
+func (b DueBucket) Slug() string {
+	switch b {
+	case DueOverdue:
+		return "overdue"
+	case DueToday:
+		return "today"
+	case DueThisWeek:
+		return "this_week"
+	case DueLater:
+		return "later"
+	default:
+		return "no_date"
+	}
+}
+
+// ParseDueDate parses a Basecamp due date (YYYY-MM-DD) in the location of
+// the reference time. It returns false for nil, empty or malformed dates.
+func ParseDueDate(dueOn *string, loc *time.Location) (time.Time, bool) {
+	if dueOn == nil || *dueOn == "" {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation("2006-01-02", *dueOn, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// DaysUntilDue returns the number of calendar days from today until the due
+// date; negative values mean the item is overdue. The second result is false
+// when the item has no usable due date.
+func DaysUntilDue(dueOn *string, today time.Time) (int, bool) {
+	due, ok := ParseDueDate(dueOn, today.Location())
+	if !ok {
+		return 0, false
+	}
+	y, m, d := today.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, today.Location())
+	// Round to absorb DST shifts between the two midnights
+	return int(due.Sub(start).Round(24*time.Hour) / (24 * time.Hour)), true
+}
+
+// DueBucketFor returns the bucket a due date falls into relative to today.
+// Weeks start on Sunday, so "this week" runs from tomorrow through Saturday.
+func DueBucketFor(dueOn *string, today time.Time) DueBucket {
+	days, ok := DaysUntilDue(dueOn, today)
+	switch {
+	case !ok:
+		return DueNoDate
+	case days < 0:
+		return DueOverdue
+	case days == 0:
+		return DueToday
+	case days <= int(time.Saturday-today.Weekday()):
+		return DueThisWeek
+	default:
+		return DueLater
+	}
+}
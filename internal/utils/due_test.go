@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestDueBucketFor(t *testing.T) {
+	// Wednesday
+	today := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		dueOn *string
+		want  DueBucket
+	}{
+		{"nil date", nil, DueNoDate},
+		{"empty date", strPtr(""), DueNoDate},
+		{"malformed date", strPtr("next week"), DueNoDate},
+		{"yesterday", strPtr("2024-05-14"), DueOverdue},
+		{"today", strPtr("2024-05-15"), DueToday},
+		{"tomorrow", strPtr("2024-05-16"), DueThisWeek},
+		{"saturday", strPtr("2024-05-18"), DueThisWeek},
+		{"sunday", strPtr("2024-05-19"), DueLater},
+		{"next month", strPtr("2024-06-15"), DueLater},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DueBucketFor(tt.dueOn, today); got != tt.want {
+				t.Errorf("DueBucketFor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDueBucketForSaturday(t *testing.T) {
+	saturday := time.Date(2024, 5, 18, 9, 0, 0, 0, time.UTC)
+
+	if got := DueBucketFor(strPtr("2024-05-19"), saturday); got != DueLater {
+		t.Errorf("expected Sunday after a Saturday to be later, got %v", got)
+	}
+}
+
+func TestDaysUntilDue(t *testing.T) {
+	today := time.Date(2024, 5, 15, 23, 59, 0, 0, time.UTC)
+
+	days, ok := DaysUntilDue(strPtr("2024-05-10"), today)
+	if !ok || days != -5 {
+		t.Errorf("DaysUntilDue() = %d, %v; want -5, true", days, ok)
+	}
+
+	days, ok = DaysUntilDue(strPtr("2024-05-22"), today)
+	if !ok || days != 7 {
+		t.Errorf("DaysUntilDue() = %d, %v; want 7, true", days, ok)
+	}
+
+	if _, ok := DaysUntilDue(nil, today); ok {
+		t.Error("expected no due date for nil input")
+	}
+}
@@ -0,0 +1,41 @@
+package utils
+
+import "strings"
+
+const frontMatterDelimiter = "---"
+
+// SplitFrontMatter separates a leading block fenced by "---" lines from the
+// rest of the document. ok is false when the content has no front matter.
+func SplitFrontMatter(content string) (front, body string, ok bool) {
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+
+	first, rest, found := strings.Cut(content, "\n")
+	if !found || strings.TrimSpace(first) != frontMatterDelimiter {
+		return "", content, false
+	}
+
+	lines := strings.Split(rest, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) == frontMatterDelimiter {
+			front = strings.Join(lines[:i], "\n")
+			body = strings.Join(lines[i+1:], "\n")
+			return front, strings.TrimLeft(body, "\n"), true
+		}
+	}
+	return "", content, false
+}
+
+// JoinFrontMatter builds a document from front matter and a body, the
+// inverse of SplitFrontMatter
+func JoinFrontMatter(front, body string) string {
+	var b strings.Builder
+	b.WriteString(frontMatterDelimiter + "\n")
+	b.WriteString(strings.TrimRight(front, "\n"))
+	b.WriteString("\n" + frontMatterDelimiter + "\n\n")
+	b.WriteString(body)
+	if body != "" && !strings.HasSuffix(body, "\n") {
+		b.WriteString("\n")
+	}
+	return b.String()
+}
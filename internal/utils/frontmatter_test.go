@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitFrontMatter(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		front   string
+		body    string
+		ok      bool
+	}{
+		{
+			name:    "front matter and body",
+			content: "---\ntitle: Hello\n---\n\nBody text\n",
+			front:   "title: Hello",
+			body:    "Body text\n",
+			ok:      true,
+		},
+		{
+			name:    "windows line endings",
+			content: "---\r\ntitle: Hello\r\n---\r\nBody\r\n",
+			front:   "title: Hello",
+			body:    "Body\n",
+			ok:      true,
+		},
+		{
+			name:    "no front matter",
+			content: "Just text\n---\nmore",
+			body:    "Just text\n---\nmore",
+		},
+		{
+			name:    "unterminated",
+			content: "---\ntitle: Hello\n",
+			body:    "---\ntitle: Hello\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			front, body, ok := SplitFrontMatter(tt.content)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.front, front)
+			assert.Equal(t, tt.body, body)
+		})
+	}
+}
+
+func TestJoinFrontMatter(t *testing.T) {
+	doc := JoinFrontMatter("title: Hello\n", "Body")
+	assert.Equal(t, "---\ntitle: Hello\n---\n\nBody\n", doc)
+
+	front, body, ok := SplitFrontMatter(doc)
+	assert.True(t, ok)
+	assert.Equal(t, "title: Hello", front)
+	assert.Equal(t, "Body\n", body)
+}
+
+func TestResolveEditor(t *testing.T) {
+	t.Setenv("VISUAL", "")
+	t.Setenv("EDITOR", "nano")
+	assert.Equal(t, "code --wait", ResolveEditor("code --wait"))
+	assert.Equal(t, "nano", ResolveEditor(""))
+
+	t.Setenv("EDITOR", "")
+	assert.Equal(t, "vi", ResolveEditor(""))
+}
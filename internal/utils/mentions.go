@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+// Mentions maps people to the @handles used for assignees in Markdown
+// checklists. A handle is the person's first name, or the local part of
+// their email address when the first name is shared.
+type Mentions struct {
+	handles  map[string]int64
+	byID     map[int64]string
+	resolver *UserResolver
+}
+
+var handleUnsafe = regexp.MustCompile(`[^\w.\-]+`)
+
+// NewMentions builds handles for people. Handles that aren't among them are
+// resolved with resolver.
+func NewMentions(people []api.Person, resolver *UserResolver) *Mentions {
+	m := &Mentions{handles: make(map[string]int64), byID: make(map[int64]string), resolver: resolver}
+
+	firstNames := make(map[string]int)
+	for _, person := range people {
+		firstNames[firstNameHandle(person)]++
+	}
+	for _, person := range people {
+		handle := firstNameHandle(person)
+		if handle == "" || firstNames[handle] > 1 {
+			handle = emailHandle(person)
+		}
+		if handle == "" {
+			handle = strconv.FormatInt(person.ID, 10)
+		}
+		m.byID[person.ID] = handle
+		m.handles[handle] = person.ID
+		if email := emailHandle(person); email != "" {
+			if _, taken := m.handles[email]; !taken {
+				m.handles[email] = person.ID
+			}
+		}
+	}
+	return m
+}
+
+func firstNameHandle(person api.Person) string {
+	fields := strings.Fields(person.Name)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.Trim(handleUnsafe.ReplaceAllString(strings.ToLower(fields[0]), ""), ".-")
+}
+
+func emailHandle(person api.Person) string {
+	local, _, found := strings.Cut(strings.ToLower(person.EmailAddress), "@")
+	if !found {
+		return ""
+	}
+	return strings.Trim(handleUnsafe.ReplaceAllString(local, ""), ".-")
+}
+
+// Mention returns the @handle of a person
+func (m *Mentions) Mention(person api.Person) string {
+	if handle, ok := m.byID[person.ID]; ok {
+		return "@" + handle
+	}
+	if handle := emailHandle(person); handle != "" {
+		return "@" + handle
+	}
+	return "@" + firstNameHandle(person)
+}
+
+// Resolve turns @handles (or names and email addresses) into person IDs,
+// dropping duplicates
+func (m *Mentions) Resolve(ctx context.Context, mentions []string) ([]int64, error) {
+	var ids []int64
+	seen := make(map[int64]bool)
+	for _, mention := range mentions {
+		id, ok := m.handles[strings.ToLower(strings.TrimPrefix(mention, "@"))]
+		if !ok {
+			resolved, err := m.resolver.ResolveUsers(ctx, []string{mention})
+			if err != nil {
+				return nil, err
+			}
+			id = resolved[0]
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
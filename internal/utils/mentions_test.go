@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/needmore/bc4/internal/api"
+)
+
+func TestMentions(t *testing.T) {
+	mentions := NewMentions([]api.Person{
+		{ID: 1, Name: "Jane Doe", EmailAddress: "jane@example.com"},
+		{ID: 2, Name: "Sam Lee", EmailAddress: "sam.lee@example.com"},
+		{ID: 3, Name: "Sam Park", EmailAddress: "spark@example.com"},
+	}, nil)
+
+	for id, want := range map[int64]string{
+		1: "@jane",
+		2: "@sam.lee", // shared first names fall back to the email
+		3: "@spark",
+	} {
+		if got := mentions.Mention(api.Person{ID: id}); got != want {
+			t.Errorf("Mention(%d) = %q, want %q", id, got, want)
+		}
+	}
+
+	ids, err := mentions.Resolve(context.Background(), []string{"@Jane", "@spark", "@jane"})
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if want := []int64{1, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Resolve() = %v, want %v", ids, want)
+	}
+}
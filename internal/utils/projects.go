@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/needmore/bc4/internal/api"
+	"github.com/needmore/bc4/internal/parser"
+)
+
+// ResolveProject finds a project by ID, URL, or name
+func ResolveProject(ctx context.Context, projectOps api.ProjectOperations, identifier string) (*api.Project, error) {
+	if parser.IsBasecampURL(identifier) {
+		parsed, err := parser.ParseBasecampURL(identifier)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Basecamp URL: %w", err)
+		}
+		if parsed.ProjectID == 0 {
+			return nil, fmt.Errorf("URL does not point to a project: %s", identifier)
+		}
+		identifier = strconv.FormatInt(parsed.ProjectID, 10)
+	}
+
+	projects, err := projectOps.GetProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch projects: %w", err)
+	}
+	project, err := MatchProject(projects, identifier)
+	if err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// MatchProject finds a project by ID, exact name, or unique partial name
+func MatchProject(projects []api.Project, identifier string) (api.Project, error) {
+	if id, err := strconv.ParseInt(identifier, 10, 64); err == nil {
+		for _, p := range projects {
+			if p.ID == id {
+				return p, nil
+			}
+		}
+		return api.Project{}, fmt.Errorf("no project found with ID %d", id)
+	}
+
+	search := strings.ToLower(identifier)
+	var matches []api.Project
+	for _, p := range projects {
+		name := strings.ToLower(p.Name)
+		if name == search {
+			return p, nil
+		}
+		if strings.Contains(name, search) {
+			matches = append(matches, p)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return api.Project{}, fmt.Errorf("no project found matching '%s'", identifier)
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, len(matches))
+		for i, p := range matches {
+			names[i] = p.Name
+		}
+		return api.Project{}, fmt.Errorf("'%s' matches multiple projects: %s", identifier, strings.Join(names, ", "))
+	}
+}
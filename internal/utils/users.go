@@ -16,7 +16,8 @@ type UserResolver struct {
 	cached    bool
 }
 
-// NewUserResolver creates a new user resolver for a project
+// NewUserResolver creates a new user resolver for a project. An empty
+// projectID resolves against everyone visible in the account.
 func NewUserResolver(client api.APIClient, projectID string) *UserResolver {
 	return &UserResolver{
 		client:    client,
@@ -119,9 +120,15 @@ func (ur *UserResolver) ensurePeopleCached(ctx context.Context) error {
 		return nil
 	}
 
-	people, err := ur.client.GetProjectPeople(ctx, ur.projectID)
+	var people []api.Person
+	var err error
+	if ur.projectID == "" {
+		people, err = ur.client.GetAllPeople(ctx)
+	} else {
+		people, err = ur.client.GetProjectPeople(ctx, ur.projectID)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to fetch project people: %w", err)
+		return fmt.Errorf("failed to fetch people: %w", err)
 	}
 
 	ur.people = people
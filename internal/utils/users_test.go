@@ -199,6 +199,26 @@ func TestUserResolver_ResolveUsers(t *testing.T) {
 	}
 }
 
+func TestUserResolver_AccountWide(t *testing.T) {
+	mockClient := mock.NewMockClient()
+	mockClient.People = []api.Person{
+		{ID: 7, Name: "Ada Lovelace", EmailAddress: "ada@example.com"},
+	}
+
+	resolver := NewUserResolver(mockClient, "")
+	ids, err := resolver.ResolveUsers(context.Background(), []string{"ada@example.com"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != 7 {
+		t.Errorf("Expected [7], got %v", ids)
+	}
+
+	if len(mockClient.Calls) != 1 || mockClient.Calls[0] != "GetAllPeople()" {
+		t.Errorf("Expected account-wide lookup, got calls %v", mockClient.Calls)
+	}
+}
+
 func TestUserResolver_resolveIdentifier(t *testing.T) {
 	// Create test people
 	ur := &UserResolver{